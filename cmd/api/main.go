@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -17,38 +19,142 @@ import (
 	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/auth"
 	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/healthcheck"
 	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/user"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
 	authdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/auth"
 	logindatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/login"
 	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/user"
 	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
+	graphqlhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/graphql"
 	healthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/healthcheck"
 	userhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/user"
+	apirouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router"
 	authrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/auth"
+	graphqlrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/graphql"
 	healthcheckrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/healthcheck"
+	metricsrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/metrics"
+	openapirouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/openapi"
 	swaggerrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/swagger"
 	userrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/user"
+	grpcauthhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/auth"
+	grpchealthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/healthcheck"
+	grpcuserhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/user"
+	grpcserver "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/server"
 	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
 	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
 	envpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/env"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
 	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
 	handlerhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/handler"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	authinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/auth"
+	dbtrxinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/dbtrx"
+	logginginterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/logging"
+	ratelimitinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/ratelimit"
+	recoveryinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/recovery"
+	requestidinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/requestid"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
 	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	bodylimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/bodylimit"
+	clientcertmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/clientcert"
+	corsmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/cors"
 	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	localemiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/locale"
 	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
+	metricsmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/metrics"
+	openapimiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/openapi"
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	recoverymiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/recovery"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	securityheadersmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/securityheaders"
+	tracingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/tracing"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
 	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
 	serverpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/server"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	validatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator"
 	passwordvalidatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator/password"
 	usernamevalidatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator/username"
 	uuidvalidatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator/uuid"
+	"github.com/redis/go-redis/v9"
 	httpswaggerpkg "github.com/swaggo/http-swagger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	validatorv2 "gopkg.in/validator.v2"
 )
 
 var (
 	httpPort = envpkg.GetEnvWithDefaultValue("HTTP_PORT", "8080")
 
+	httpReadTimeoutInSec       = envpkg.GetEnvWithDefaultValue("HTTP_READ_TIMEOUT_IN_SEC", "15")
+	httpReadHeaderTimeoutInSec = envpkg.GetEnvWithDefaultValue("HTTP_READ_HEADER_TIMEOUT_IN_SEC", "5")
+	httpWriteTimeoutInSec      = envpkg.GetEnvWithDefaultValue("HTTP_WRITE_TIMEOUT_IN_SEC", "30")
+	httpIdleTimeoutInSec       = envpkg.GetEnvWithDefaultValue("HTTP_IDLE_TIMEOUT_IN_SEC", "120")
+	httpMaxHeaderBytes         = envpkg.GetEnvWithDefaultValue("HTTP_MAX_HEADER_BYTES", "1048576")
+	httpH2C                    = envpkg.GetEnvWithDefaultValue("HTTP_H2C", "false")
+	httpUnixSocket             = envpkg.GetEnvWithDefaultValue("HTTP_UNIX_SOCKET", "")
+	httpSystemdSocket          = envpkg.GetEnvWithDefaultValue("HTTP_SYSTEMD_SOCKET", "false")
+
+	shutdownDrainPeriodInSec = envpkg.GetEnvWithDefaultValue("SHUTDOWN_DRAIN_PERIOD_IN_SEC", "5")
+	shutdownTimeoutInSec     = envpkg.GetEnvWithDefaultValue("SHUTDOWN_TIMEOUT_IN_SEC", "10")
+
+	healthCheckTimeoutInSec = envpkg.GetEnvWithDefaultValue("HEALTH_CHECK_TIMEOUT_IN_SEC", "2")
+
+	tlsCertFile            = envpkg.GetEnvWithDefaultValue("TLS_CERT_FILE", "")
+	tlsKeyFile             = envpkg.GetEnvWithDefaultValue("TLS_KEY_FILE", "")
+	tlsMinVersion          = envpkg.GetEnvWithDefaultValue("TLS_MIN_VERSION", "1.2")
+	tlsCipherSuites        = envpkg.GetEnvWithDefaultValue("TLS_CIPHER_SUITES", "")
+	tlsClientCAFile        = envpkg.GetEnvWithDefaultValue("TLS_CLIENT_CA_FILE", "")
+	tlsClientAuth          = envpkg.GetEnvWithDefaultValue("TLS_CLIENT_AUTH", "none")
+	tlsReloadIntervalInSec = envpkg.GetEnvWithDefaultValue("TLS_RELOAD_INTERVAL_IN_SEC", "10")
+
+	metricsPort = envpkg.GetEnvWithDefaultValue("METRICS_PORT", "")
+
+	grpcPort                     = envpkg.GetEnvWithDefaultValue("GRPC_PORT", "9090")
+	grpcHealthWatchIntervalInSec = envpkg.GetEnvWithDefaultValue("GRPC_HEALTH_WATCH_INTERVAL_IN_SEC", "5")
+
+	graphQLMaxDepth        = envpkg.GetEnvWithDefaultValue("GRAPHQL_MAX_DEPTH", "10")
+	graphQLMaxComplexity   = envpkg.GetEnvWithDefaultValue("GRAPHQL_MAX_COMPLEXITY", "1000")
+	graphQLDefaultPageSize = envpkg.GetEnvWithDefaultValue("GRAPHQL_DEFAULT_PAGE_SIZE", "20")
+	graphQLMaxPageSize     = envpkg.GetEnvWithDefaultValue("GRAPHQL_MAX_PAGE_SIZE", "100")
+
+	tracingExporter    = envpkg.GetEnvWithDefaultValue("TRACING_EXPORTER", "none")
+	tracingFilePath    = envpkg.GetEnvWithDefaultValue("TRACING_FILE_PATH", "./traces.json")
+	tracingSampleRatio = envpkg.GetEnvWithDefaultValue("TRACING_SAMPLE_RATIO", "1")
+	tracingServiceName = envpkg.GetEnvWithDefaultValue("TRACING_SERVICE_NAME", "go-code-challenge-template")
+
+	corsAllowedOrigins   = envpkg.GetEnvWithDefaultValue("CORS_ALLOWED_ORIGINS", "")
+	corsAllowedMethods   = envpkg.GetEnvWithDefaultValue("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE")
+	corsAllowedHeaders   = envpkg.GetEnvWithDefaultValue("CORS_ALLOWED_HEADERS", "Authorization,Content-Type,If-Match,X-Request-ID")
+	corsExposedHeaders   = envpkg.GetEnvWithDefaultValue("CORS_EXPOSED_HEADERS", "ETag,X-Request-ID,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,RateLimit-Policy,Retry-After")
+	corsAllowCredentials = envpkg.GetEnvWithDefaultValue("CORS_ALLOW_CREDENTIALS", "false")
+	corsMaxAgeInSec      = envpkg.GetEnvWithDefaultValue("CORS_MAX_AGE_IN_SEC", "600")
+
+	hstsMaxAgeInSec           = envpkg.GetEnvWithDefaultValue("HSTS_MAX_AGE_IN_SEC", "31536000")
+	frameOptions              = envpkg.GetEnvWithDefaultValue("FRAME_OPTIONS", "DENY")
+	maxRequestBodySizeInBytes = envpkg.GetEnvWithDefaultValue("MAX_REQUEST_BODY_SIZE_IN_BYTES", "1048576")
+
+	problemTypeBaseURI = envpkg.GetEnvWithDefaultValue("PROBLEM_TYPE_BASE_URI", "")
+
+	unversionedRoutes       = envpkg.GetEnvWithDefaultValue("UNVERSIONED_ROUTES", "true")
+	unversionedRoutesSunset = envpkg.GetEnvWithDefaultValue("UNVERSIONED_ROUTES_SUNSET", "")
+
+	openAPIValidateRequests  = envpkg.GetEnvWithDefaultValue("OPENAPI_VALIDATE_REQUESTS", "false")
+	openAPIValidateResponses = envpkg.GetEnvWithDefaultValue("OPENAPI_VALIDATE_RESPONSES", "false")
+
+	rateLimitStoreType      = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_STORE", "memory")
+	rateLimitRedisURL       = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_REDIS_URL", "redis://localhost:6379/0")
+	rateLimitTrustedProxies = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_TRUSTED_PROXIES", "")
+	rateLimitSignUp         = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_SIGN_UP", "5/1m")
+	rateLimitSignIn         = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_SIGN_IN", "10/1m")
+	rateLimitChangePassword = envpkg.GetEnvWithDefaultValue("RATE_LIMIT_CHANGE_PASSWORD", "5/1m")
+
+	logFormat = envpkg.GetEnvWithDefaultValue("LOG_FORMAT", "json")
+	logLevel  = envpkg.GetEnvWithDefaultValue("LOG_LEVEL", "info")
+
 	publicKeyPath                  = envpkg.GetEnvWithDefaultValue("RSA_PUBLIC_KEY_PATH", "./configs/auth/rsa_keys/rsa.public")
 	privateKeyPath                 = envpkg.GetEnvWithDefaultValue("RSA_PRIVATE_KEY_PATH", "./configs/auth/rsa_keys/rsa.private")
 	tokenExpTimeInSecStr           = envpkg.GetEnvWithDefaultValue("TOKEN_EXP_TIME_IN_SEC", "120")
@@ -60,6 +166,23 @@ var (
 	dbHost     = envpkg.GetEnvWithDefaultValue("DB_HOST", "localhost")
 	dbPort     = envpkg.GetEnvWithDefaultValue("DB_PORT", "5433")
 	dbName     = envpkg.GetEnvWithDefaultValue("DB_NAME", "db")
+
+	dbSSLMode     = envpkg.GetEnvWithDefaultValue("DB_SSL_MODE", "disable")
+	dbSSLRootCert = envpkg.GetEnvWithDefaultValue("DB_SSL_ROOT_CERT", "")
+	dbSSLCert     = envpkg.GetEnvWithDefaultValue("DB_SSL_CERT", "")
+	dbSSLKey      = envpkg.GetEnvWithDefaultValue("DB_SSL_KEY", "")
+
+	dbMaxOpenConns             = envpkg.GetEnvWithDefaultValue("DB_MAX_OPEN_CONNS", "25")
+	dbMaxIdleConns             = envpkg.GetEnvWithDefaultValue("DB_MAX_IDLE_CONNS", "25")
+	dbConnMaxLifetimeInSec     = envpkg.GetEnvWithDefaultValue("DB_CONN_MAX_LIFETIME_IN_SEC", "1800")
+	dbConnMaxIdleTimeInSec     = envpkg.GetEnvWithDefaultValue("DB_CONN_MAX_IDLE_TIME_IN_SEC", "300")
+	dbConnectTimeoutInSec      = envpkg.GetEnvWithDefaultValue("DB_CONNECT_TIMEOUT_IN_SEC", "60")
+	dbConnectRetryIntervalInMs = envpkg.GetEnvWithDefaultValue("DB_CONNECT_RETRY_INTERVAL_IN_MS", "500")
+
+	dbReplicaURLs                     = envpkg.GetEnvWithDefaultValue("DB_REPLICA_URLS", "")
+	dbReplicaPolicy                   = envpkg.GetEnvWithDefaultValue("DB_REPLICA_POLICY", "round_robin")
+	dbReplicaMaxLagInSec              = envpkg.GetEnvWithDefaultValue("DB_REPLICA_MAX_LAG_IN_SEC", "10")
+	dbReplicaHealthCheckIntervalInSec = envpkg.GetEnvWithDefaultValue("DB_REPLICA_HEALTH_CHECK_INTERVAL_IN_SEC", "5")
 )
 
 // @title New Go Code Challenge Template API
@@ -71,6 +194,8 @@ var (
 // @tag.description It refers to the operations related to authentication.
 // @tag.name user
 // @tag.description It refers to the operations related to user.
+// @tag.name graphql
+// @tag.description It refers to the operation related to GraphQL.
 // @termsOfService http://swagger.io/terms/
 // @contact.name API Support
 // @contact.email icaroribeiro@hotmail.com
@@ -81,43 +206,87 @@ var (
 // @in header
 // @name Authorization
 func main() {
+	logger, err := setupLogger()
+	if err != nil {
+		log.Panic(err.Error())
+	}
+
+	slog.SetDefault(logger)
+
+	responsehttputilpkg.SetProblemTypeBaseURI(problemTypeBaseURI)
+
+	catalog, err := i18npkg.New()
+	if err != nil {
+		logPanic("failed to load the catalog of the messages", err)
+	}
+
+	responsehttputilpkg.SetCatalog(catalog)
+
 	httpPort := setupHttpPort()
 
+	tracingConfig, err := setupTracingConfig()
+	if err != nil {
+		logPanic("failed to set up the tracing config", err)
+	}
+
+	shutdownTracing, err := tracingpkg.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		logPanic("failed to set up the tracing", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("failed to shut down the tracing", slog.Any("error", err))
+		}
+	}()
+
 	rsaKeys, err := setupRSAKeys()
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to set up the RSA keys", err)
 	}
 
 	authN := authpkg.New(rsaKeys)
 
 	tokenExpTimeInSec, err := strconv.Atoi(tokenExpTimeInSecStr)
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to parse the token expiration time", err)
 	}
 
 	timeBeforeTokenExpTimeInSec, err := strconv.Atoi(timeBeforeTokenExpTimeInSecStr)
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to parse the time before the token expiration time", err)
 	}
 
 	dbConfig, err := setupDBConfig()
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to set up the database config", err)
 	}
 
 	datastore, err := datastorepkg.New(dbConfig)
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to connect to the database", err)
 	}
 	defer datastore.Close()
 
 	db := datastore.GetInstance()
 	if db == nil {
-		log.Panicf("The database instance is null")
+		logPanic("failed to get the database instance", fmt.Errorf("the database instance is null"))
 	}
 
 	if err = db.Error; err != nil {
-		log.Panicf("Got error when acessing the database instance: %s", err.Error())
+		logPanic("failed to access the database instance", err)
+	}
+
+	if err = db.Use(tracingpkg.NewGormPlugin()); err != nil {
+		logPanic("failed to register the database tracing plugin", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		logPanic("failed to access the database connection pool", err)
+	}
+
+	if err = metricspkg.RegisterDBStats(sqlDB, dbName); err != nil {
+		logPanic("failed to register the database metrics", err)
 	}
 
 	persistentAuthRepository := authdatastorerepository.New(db)
@@ -132,12 +301,49 @@ func main() {
 
 	validator, err := validatorpkg.New(validationFuncs)
 	if err != nil {
-		log.Panic(err.Error())
+		logPanic("failed to set up the validator", err)
 	}
 
 	security := securitypkg.New()
 
-	healthCheckService := healthcheckservice.New(db)
+	rateLimitStore, err := setupRateLimitStore()
+	if err != nil {
+		logPanic("failed to set up the rate limit store", err)
+	}
+	defer rateLimitStore.Close()
+
+	rateLimitRules, err := setupRateLimitRules()
+	if err != nil {
+		logPanic("failed to set up the rate limit rules", err)
+	}
+
+	trustedProxies, err := ratelimitmiddlewarepkg.ParseTrustedProxies(rateLimitTrustedProxies)
+	if err != nil {
+		logPanic("failed to parse the rate limit trusted proxies", err)
+	}
+
+	// The drainer makes the health check fail while the application is shutting down.
+	drainer := serverpkg.NewDrainer()
+
+	healthCheckTimeout, err := parseSeconds("health check timeout", healthCheckTimeoutInSec)
+	if err != nil {
+		logPanic("failed to set up the health check", err)
+	}
+
+	// The readiness relies on the checkers registered here, so new dependencies only need to register their own.
+	healthRegistry := healthpkg.NewRegistry(healthCheckTimeout)
+	healthRegistry.Register(
+		datastorepkg.NewPingChecker(db),
+		datastorepkg.NewSchemaChecker(db, &persistententity.User{}, &persistententity.Login{}, &persistententity.Auth{}),
+		authpkg.NewKeysChecker(rsaKeys),
+		drainer,
+	)
+
+	if checker, ok := rateLimitStore.(healthpkg.Checker); ok {
+		healthRegistry.Register(checker)
+	}
+
+	healthCheckService := healthcheckservice.New(db, drainer.IsDraining, healthRegistry)
 	authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 		authN, security, validator, tokenExpTimeInSec)
 	userService := userservice.New(persistentUserRepository, validator)
@@ -146,43 +352,448 @@ func main() {
 	healthCheckHandler := healthcheckhandler.New(healthCheckService)
 	authHandler := authhandler.New(authService)
 	userHandler := userhandler.New(userService)
+	metricsHandler := metricspkg.Handler().ServeHTTP
+
+	graphQLConfig, err := setupGraphQLConfig()
+	if err != nil {
+		logPanic("failed to set up the GraphQL config", err)
+	}
+
+	graphQLHandler, err := graphqlhandler.New(userService, authService, graphQLConfig)
+	if err != nil {
+		logPanic("failed to set up the GraphQL handler", err)
+	}
 
-	adapters := map[string]adapterhttputilpkg.Adapter{
-		"loggingMiddleware":     loggingmiddlewarepkg.Logging(),
-		"authMiddleware":        authmiddlewarepkg.Auth(db, authN),
-		"authRenewalMiddleware": authmiddlewarepkg.AuthRenewal(db, authN, timeBeforeTokenExpTimeInSec),
-		"dbTrxMiddleware":       dbtrxmiddlewarepkg.DBTrx(db),
+	middlewares := apirouter.Middlewares{
+		Metrics:     metricsmiddlewarepkg.Metrics(),
+		Logging:     loggingmiddlewarepkg.Logging(logger),
+		Tracing:     tracingmiddlewarepkg.Tracing(),
+		Recovery:    recoverymiddlewarepkg.Recovery(),
+		Auth:        authmiddlewarepkg.Auth(db, authN),
+		AuthRenewal: authmiddlewarepkg.AuthRenewal(db, authN, timeBeforeTokenExpTimeInSec),
+		DBTrx:       dbtrxmiddlewarepkg.DBTrx(db),
+		RateLimits:  make(map[string]adapterhttputilpkg.Adapter, len(rateLimitRules)),
+	}
+
+	for policyName, rule := range rateLimitRules {
+		middlewares.RateLimits[policyName] = nil
+		if rule != nil {
+			middlewares.RateLimits[policyName] = ratelimitmiddlewarepkg.Throttle(rateLimitStore, *rule, trustedProxies)
+		}
 	}
 
 	routes := make(routehttputilpkg.Routes, 0)
-	routes = append(routes, swaggerrouter.ConfigureRoutes(swaggerHandler, adapters)...)
-	routes = append(routes, healthcheckrouter.ConfigureRoutes(healthCheckHandler, adapters)...)
-	routes = append(routes, authrouter.ConfigureRoutes(authHandler, adapters)...)
-	routes = append(routes, userrouter.ConfigureRoutes(userHandler, adapters)...)
+	routes = append(routes, swaggerrouter.ConfigureRoutes(swaggerHandler)...)
+	routes = append(routes, healthcheckrouter.ConfigureRoutes(healthCheckHandler)...)
+	routes = append(routes, authrouter.ConfigureRoutes(authHandler)...)
+	routes = append(routes, userrouter.ConfigureRoutes(userHandler)...)
+	routes = append(routes, graphqlrouter.ConfigureRoutes(graphQLHandler)...)
+
+	routes, err = setupUnversionedRoutes(routes)
+	if err != nil {
+		logPanic("failed to set up the unversioned routes", err)
+	}
+
+	metricsRoutes := metricsrouter.ConfigureRoutes(metricsHandler)
+
+	// The document describes the routes served by the API server, which include the metrics ones
+	// unless they are served by a separate admin port.
+	documentedRoutes := append(routehttputilpkg.Routes{}, routes...)
+	if metricsPort == "" {
+		documentedRoutes = append(documentedRoutes, metricsRoutes...)
+	}
+
+	openAPIDocument := openapipkg.Generate(openapipkg.Info{
+		Title:       "New Go Code Challenge Template API",
+		Description: "A REST API developed using Golang, Json Web Token and PostgreSQL database.",
+		Version:     "1.0",
+	}, documentedRoutes)
+
+	routes = append(routes, openapirouter.ConfigureRoutes(openapipkg.Handler(openAPIDocument))...)
+
+	openAPIValidationConfig, err := setupOpenAPIValidationConfig()
+	if err != nil {
+		logPanic("failed to set up the OpenAPI validation config", err)
+	}
+
+	if openAPIValidationConfig.Requests || openAPIValidationConfig.Responses {
+		middlewares.Validation = func(route routehttputilpkg.Route) adapterhttputilpkg.Adapter {
+			return openapimiddlewarepkg.Validate(openAPIDocument, route, openAPIValidationConfig)
+		}
+	}
+
+	routes, err = apirouter.Build(routes, middlewares)
+	if err != nil {
+		logPanic("failed to build the routes", err)
+	}
+
+	metricsRoutes, err = apirouter.Build(metricsRoutes, middlewares)
+	if err != nil {
+		logPanic("failed to build the metrics routes", err)
+	}
+
+	// The metrics are served by the API server unless a separate admin port is configured.
+	servers := make([]stoppableServer, 0)
+
+	if metricsPort == "" {
+		routes = append(routes, metricsRoutes...)
+	} else {
+		logger.Debug("the metrics routes are configured", slog.String("routes", metricsRoutes.Listing()))
+
+		metricsServer := serverpkg.New(fmt.Sprintf(":%s", metricsPort), setupRouter(metricsRoutes))
+		servers = append(servers, metricsServer)
+
+		go func() {
+			logger.Info("metrics server is starting", slog.String("port", metricsPort))
+
+			if err := metricsServer.Start(); err != nil && err != http.ErrServerClosed {
+				logPanic("failed to start the metrics server", err)
+			}
+		}()
+	}
+
+	corsConfig, err := setupCORSConfig()
+	if err != nil {
+		logPanic("failed to set up the CORS config", err)
+	}
+
+	securityHeadersConfig, err := setupSecurityHeadersConfig()
+	if err != nil {
+		logPanic("failed to set up the security headers config", err)
+	}
+
+	maxBodyBytes, err := strconv.ParseInt(maxRequestBodySizeInBytes, 10, 64)
+	if err != nil {
+		logPanic("failed to parse the max request body size", err)
+	}
+
+	logger.Debug("the routes are configured", slog.String("routes", routes.Listing()))
+
+	router := setupRouter(routes,
+		corsmiddlewarepkg.CORS(corsConfig),
+		securityheadersmiddlewarepkg.SecurityHeaders(securityHeadersConfig),
+		bodylimitmiddlewarepkg.BodyLimit(maxBodyBytes),
+		clientcertmiddlewarepkg.ClientCert())
 
-	router := setupRouter(routes)
+	// The request ID and locale middlewares wrap the whole router, so that every response is identified
+	// and its error messages are localized, including the ones for routes that are not found.
+	requestIDMiddleware := requestidmiddlewarepkg.RequestID()
+	localeMiddleware := localemiddlewarepkg.Locale(catalog)
 
-	server := serverpkg.New(fmt.Sprintf(":%s", httpPort), router)
+	server, err := setupServer(fmt.Sprintf(":%s", httpPort), requestIDMiddleware(localeMiddleware(router.ServeHTTP)))
+	if err != nil {
+		logPanic("failed to set up the server", err)
+	}
+	servers = append(servers, server)
+
+	// The gRPC server serves the auth and user operations to the internal services on its own port, unless it is disabled.
+	if grpcPort != "" {
+		grpcHealthWatchInterval, err := parseSeconds("gRPC health watch interval", grpcHealthWatchIntervalInSec)
+		if err != nil {
+			logPanic("failed to set up the gRPC health watch interval", err)
+		}
+
+		// The calls are throttled with the same store and policies as the requests of the API, and are served
+		// over the same TLS config, so that the port does not bypass the protections of the API.
+		grpcOptions := []grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(
+				requestidinterceptorpkg.RequestID(),
+				logginginterceptorpkg.Logging(logger),
+				recoveryinterceptorpkg.Recovery(),
+				authinterceptorpkg.Auth(db, authN, timeBeforeTokenExpTimeInSec, grpcserver.Methods),
+				ratelimitinterceptorpkg.RateLimit(rateLimitStore, rateLimitRules, grpcserver.Methods),
+				dbtrxinterceptorpkg.DBTrx(db, grpcserver.Methods),
+			),
+			grpc.ChainStreamInterceptor(recoveryinterceptorpkg.StreamRecovery()),
+		}
+
+		if server.IsTLS() {
+			grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(server.Instance.TLSConfig)))
+		}
+
+		grpcServer, err := grpcserver.New(fmt.Sprintf(":%s", grpcPort),
+			grpcserver.Handlers{
+				Auth:        grpcauthhandler.New(authService),
+				User:        grpcuserhandler.New(userService),
+				HealthCheck: grpchealthcheckhandler.New(healthCheckService, grpcserver.ServiceNames, grpcHealthWatchInterval),
+			},
+			grpcOptions...,
+		)
+		if err != nil {
+			logPanic("failed to set up the gRPC server", err)
+		}
+
+		servers = append(servers, grpcServer)
+
+		go func() {
+			logger.Info("gRPC server is starting", slog.String("address", grpcServer.Address()), slog.Bool("tls", server.IsTLS()))
+
+			if err := grpcServer.Start(); err != nil && err != grpc.ErrServerStopped {
+				logPanic("failed to start the gRPC server", err)
+			}
+		}()
+	}
+
+	drainPeriod, err := parseSeconds("shutdown drain period", shutdownDrainPeriodInSec)
+	if err != nil {
+		logPanic("failed to set up the shutdown drain period", err)
+	}
+
+	shutdownTimeout, err := parseSeconds("shutdown timeout", shutdownTimeoutInSec)
+	if err != nil {
+		logPanic("failed to set up the shutdown timeout", err)
+	}
 
 	idleChan := make(chan struct{})
 
 	go func() {
-		waitForShutdown(*server)
+		waitForShutdown(drainer, drainPeriod, shutdownTimeout, servers...)
 		close(idleChan)
 	}()
 
+	logger.Info("server is starting", slog.String("address", server.Address()), slog.Bool("tls", server.IsTLS()))
+
 	if err := server.Start(); err != nil && err != http.ErrServerClosed {
-		log.Panicf("%s", err.Error())
+		logPanic("failed to start the server", err)
 	}
 
 	<-idleChan
 }
 
+// setupLogger is the function that configures the logger used by the application.
+func setupLogger() (*slog.Logger, error) {
+	return loggerpkg.New(os.Stdout, logFormat, logLevel)
+}
+
+// logPanic is the function that logs an error with the default logger and then panics,
+// so that the deferred functions are still run.
+func logPanic(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	panic(err)
+}
+
 // setupHttpPort is the function that configures the port address used by the server.
 func setupHttpPort() string {
 	return httpPort
 }
 
+// parseSeconds is the function that parses a number of seconds into a duration.
+func parseSeconds(name string, value string) (time.Duration, error) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the %s: %s", name, err.Error())
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// setupServerOptions is the function that configures the settings of the API server.
+// It serves HTTPS when both the certificate and the key files are informed, and plain HTTP otherwise.
+func setupServerOptions() (serverpkg.Options, error) {
+	options := serverpkg.Options{}
+
+	durations := []struct {
+		name  string
+		value string
+		field *time.Duration
+	}{
+		{name: "HTTP read timeout", value: httpReadTimeoutInSec, field: &options.ReadTimeout},
+		{name: "HTTP read header timeout", value: httpReadHeaderTimeoutInSec, field: &options.ReadHeaderTimeout},
+		{name: "HTTP write timeout", value: httpWriteTimeoutInSec, field: &options.WriteTimeout},
+		{name: "HTTP idle timeout", value: httpIdleTimeoutInSec, field: &options.IdleTimeout},
+	}
+
+	for _, duration := range durations {
+		value, err := parseSeconds(duration.name, duration.value)
+		if err != nil {
+			return serverpkg.Options{}, err
+		}
+
+		*duration.field = value
+	}
+
+	maxHeaderBytes, err := strconv.Atoi(httpMaxHeaderBytes)
+	if err != nil {
+		return serverpkg.Options{}, fmt.Errorf("failed to parse the HTTP max header bytes: %s", err.Error())
+	}
+	options.MaxHeaderBytes = maxHeaderBytes
+
+	if options.H2C, err = strconv.ParseBool(httpH2C); err != nil {
+		return serverpkg.Options{}, fmt.Errorf("failed to parse the HTTP h2c flag: %s", err.Error())
+	}
+
+	if options.SystemdSocket, err = strconv.ParseBool(httpSystemdSocket); err != nil {
+		return serverpkg.Options{}, fmt.Errorf("failed to parse the HTTP systemd socket flag: %s", err.Error())
+	}
+
+	options.UnixSocket = httpUnixSocket
+
+	if tlsCertFile == "" && tlsKeyFile == "" {
+		return options, nil
+	}
+
+	reloadInterval, err := parseSeconds("TLS reload interval", tlsReloadIntervalInSec)
+	if err != nil {
+		return serverpkg.Options{}, err
+	}
+
+	options.TLS = &serverpkg.TLSConfig{
+		CertFile:       tlsCertFile,
+		KeyFile:        tlsKeyFile,
+		MinVersion:     tlsMinVersion,
+		CipherSuites:   splitList(tlsCipherSuites),
+		ClientCAFile:   tlsClientCAFile,
+		ClientAuth:     tlsClientAuth,
+		ReloadInterval: reloadInterval,
+	}
+
+	return options, nil
+}
+
+// setupServer is the function that configures the API server.
+func setupServer(tcpAddress string, handler http.Handler) (*serverpkg.Server, error) {
+	options, err := setupServerOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return serverpkg.NewWithOptions(tcpAddress, handler, options)
+}
+
+// setupTracingConfig is the function that configures the settings used to set up the tracing.
+func setupTracingConfig() (tracingpkg.Config, error) {
+	sampleRatio, err := strconv.ParseFloat(tracingSampleRatio, 64)
+	if err != nil {
+		return tracingpkg.Config{}, fmt.Errorf("failed to parse the tracing sample ratio: %s", err.Error())
+	}
+
+	return tracingpkg.Config{
+		ServiceName: tracingServiceName,
+		Exporter:    tracingExporter,
+		FilePath:    tracingFilePath,
+		SampleRatio: sampleRatio,
+	}, nil
+}
+
+// splitList is the function that splits a comma-separated list, skipping the empty items.
+func splitList(value string) []string {
+	items := make([]string, 0)
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// setupCORSConfig is the function that configures the cross-origin requests that are allowed.
+// No cross-origin request is allowed when the list of allowed origins is empty.
+func setupCORSConfig() (corsmiddlewarepkg.Config, error) {
+	allowCredentials, err := strconv.ParseBool(corsAllowCredentials)
+	if err != nil {
+		return corsmiddlewarepkg.Config{}, fmt.Errorf("failed to parse the CORS allow credentials flag: %s", err.Error())
+	}
+
+	maxAgeInSec, err := strconv.Atoi(corsMaxAgeInSec)
+	if err != nil {
+		return corsmiddlewarepkg.Config{}, fmt.Errorf("failed to parse the CORS max age: %s", err.Error())
+	}
+
+	config := corsmiddlewarepkg.Config{
+		AllowedOrigins:   splitList(corsAllowedOrigins),
+		AllowedMethods:   splitList(corsAllowedMethods),
+		AllowedHeaders:   splitList(corsAllowedHeaders),
+		ExposedHeaders:   splitList(corsExposedHeaders),
+		AllowCredentials: allowCredentials,
+		MaxAge:           time.Duration(maxAgeInSec) * time.Second,
+	}
+
+	if err := config.Validate(); err != nil {
+		return corsmiddlewarepkg.Config{}, fmt.Errorf("failed to validate the CORS config: %s", err.Error())
+	}
+
+	return config, nil
+}
+
+// setupSecurityHeadersConfig is the function that configures the security headers set in the responses.
+// The swagger UI gets a less strict content security policy, since its page relies on inline scripts and styles.
+func setupSecurityHeadersConfig() (securityheadersmiddlewarepkg.Config, error) {
+	hstsMaxAge, err := strconv.Atoi(hstsMaxAgeInSec)
+	if err != nil {
+		return securityheadersmiddlewarepkg.Config{}, fmt.Errorf("failed to parse the HSTS max age: %s", err.Error())
+	}
+
+	return securityheadersmiddlewarepkg.Config{
+		HSTSMaxAge:            time.Duration(hstsMaxAge) * time.Second,
+		HSTSIncludeSubdomains: true,
+		FrameOptions:          frameOptions,
+		ContentSecurityPolicy: securityheadersmiddlewarepkg.APIContentSecurityPolicy,
+		RouteContentSecurityPolicies: map[string]string{
+			"Swagger": securityheadersmiddlewarepkg.SwaggerUIContentSecurityPolicy,
+		},
+	}, nil
+}
+
+// setupRateLimitStore is the function that configures the store that keeps the rate limit buckets.
+// The in-memory store is used by default, while the Redis one shares the buckets among the replicas.
+func setupRateLimitStore() (ratelimitpkg.Store, error) {
+	switch rateLimitStoreType {
+	case "memory":
+		return ratelimitpkg.NewMemoryStore(), nil
+	case "redis":
+		options, err := redis.ParseURL(rateLimitRedisURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the rate limit Redis URL: %s", err.Error())
+		}
+
+		client := redis.NewClient(options)
+
+		if err = client.Ping(context.Background()).Err(); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to connect to the rate limit Redis: %s", err.Error())
+		}
+
+		return ratelimitpkg.NewRedisStore(client), nil
+	default:
+		return nil, fmt.Errorf("the rate limit store %q is not supported", rateLimitStoreType)
+	}
+}
+
+// setupRateLimitRules is the function that configures the rules of the rate limit policies by name.
+// A policy whose value is empty is disabled, so that it maps to no rule.
+func setupRateLimitRules() (map[string]*ratelimitmiddlewarepkg.Rule, error) {
+	policies := []struct {
+		name  string
+		value string
+		keyBy string
+	}{
+		{name: authrouter.RateLimitPolicySignUp, value: rateLimitSignUp, keyBy: ratelimitmiddlewarepkg.KeyByIP},
+		{name: authrouter.RateLimitPolicySignIn, value: rateLimitSignIn, keyBy: ratelimitmiddlewarepkg.KeyByIP},
+		{name: authrouter.RateLimitPolicyChangePassword, value: rateLimitChangePassword, keyBy: ratelimitmiddlewarepkg.KeyByUser},
+	}
+
+	rules := make(map[string]*ratelimitmiddlewarepkg.Rule)
+
+	for _, policy := range policies {
+		rules[policy.name] = nil
+
+		if policy.value == "" {
+			continue
+		}
+
+		ratePolicy, err := ratelimitpkg.ParsePolicy(policy.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the rate limit policy %s: %s", policy.name, err.Error())
+		}
+
+		rules[policy.name] = &ratelimitmiddlewarepkg.Rule{Policy: ratePolicy, KeyBy: policy.keyBy}
+	}
+
+	return rules, nil
+}
+
 // setupRSAKeys is the function that configures the RSA keys.
 func setupRSAKeys() (authpkg.RSAKeys, error) {
 	publicKey, err := os.ReadFile(publicKeyPath)
@@ -223,23 +834,139 @@ func setupDBConfig() (map[string]string, error) {
 		"PORT":     dbPort,
 		"NAME":     dbName,
 		"URL":      dbURL,
+
+		"SSL_MODE":      dbSSLMode,
+		"SSL_ROOT_CERT": dbSSLRootCert,
+		"SSL_CERT":      dbSSLCert,
+		"SSL_KEY":       dbSSLKey,
+
+		"MAX_OPEN_CONNS":               dbMaxOpenConns,
+		"MAX_IDLE_CONNS":               dbMaxIdleConns,
+		"CONN_MAX_LIFETIME_IN_SEC":     dbConnMaxLifetimeInSec,
+		"CONN_MAX_IDLE_TIME_IN_SEC":    dbConnMaxIdleTimeInSec,
+		"CONNECT_TIMEOUT_IN_SEC":       dbConnectTimeoutInSec,
+		"CONNECT_RETRY_INTERVAL_IN_MS": dbConnectRetryIntervalInMs,
+
+		"REPLICA_URLS":                         dbReplicaURLs,
+		"REPLICA_POLICY":                       dbReplicaPolicy,
+		"REPLICA_MAX_LAG_IN_SEC":               dbReplicaMaxLagInSec,
+		"REPLICA_HEALTH_CHECK_INTERVAL_IN_SEC": dbReplicaHealthCheckIntervalInSec,
 	}
 
 	return dbConfig, nil
 }
 
+// setupUnversionedRoutes is the function that adds an unversioned alias of each versioned route, such as /sign_up
+// for /v1/sign_up, unless they are disabled. The aliases are deprecated and are going to be removed at the sunset, if any.
+func setupUnversionedRoutes(routes routehttputilpkg.Routes) (routehttputilpkg.Routes, error) {
+	enabled, err := strconv.ParseBool(unversionedRoutes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the unversioned routes flag: %s", err.Error())
+	}
+
+	if !enabled {
+		return routes, nil
+	}
+
+	deprecation := routehttputilpkg.Deprecation{}
+
+	if unversionedRoutesSunset != "" {
+		if deprecation.Sunset, err = time.Parse(time.RFC3339, unversionedRoutesSunset); err != nil {
+			return nil, fmt.Errorf("failed to parse the sunset of the unversioned routes: %s", err.Error())
+		}
+	}
+
+	return routes.WithUnversionedAliases(deprecation), nil
+}
+
+// setupGraphQLConfig is the function that sets up the limits of the GraphQL queries and the sizes of the pages of
+// their connections.
+func setupGraphQLConfig() (graphqlhandler.Config, error) {
+	maxDepth, err := strconv.Atoi(graphQLMaxDepth)
+	if err != nil {
+		return graphqlhandler.Config{}, fmt.Errorf("failed to parse the GraphQL max depth: %s", err.Error())
+	}
+
+	maxComplexity, err := strconv.Atoi(graphQLMaxComplexity)
+	if err != nil {
+		return graphqlhandler.Config{}, fmt.Errorf("failed to parse the GraphQL max complexity: %s", err.Error())
+	}
+
+	defaultPageSize, err := strconv.Atoi(graphQLDefaultPageSize)
+	if err != nil {
+		return graphqlhandler.Config{}, fmt.Errorf("failed to parse the GraphQL default page size: %s", err.Error())
+	}
+
+	maxPageSize, err := strconv.Atoi(graphQLMaxPageSize)
+	if err != nil {
+		return graphqlhandler.Config{}, fmt.Errorf("failed to parse the GraphQL max page size: %s", err.Error())
+	}
+
+	return graphqlhandler.Config{
+		MaxDepth:        maxDepth,
+		MaxComplexity:   maxComplexity,
+		DefaultPageSize: defaultPageSize,
+		MaxPageSize:     maxPageSize,
+	}, nil
+}
+
+// setupOpenAPIValidationConfig is the function that sets up the validation of the requests and the responses
+// against the OpenAPI document. The responses are meant to be validated in the tests only, since they are buffered.
+func setupOpenAPIValidationConfig() (openapimiddlewarepkg.Config, error) {
+	requests, err := strconv.ParseBool(openAPIValidateRequests)
+	if err != nil {
+		return openapimiddlewarepkg.Config{}, fmt.Errorf("failed to parse the OpenAPI request validation flag: %s", err.Error())
+	}
+
+	responses, err := strconv.ParseBool(openAPIValidateResponses)
+	if err != nil {
+		return openapimiddlewarepkg.Config{}, fmt.Errorf("failed to parse the OpenAPI response validation flag: %s", err.Error())
+	}
+
+	return openapimiddlewarepkg.Config{Requests: requests, Responses: responses}, nil
+}
+
 // setupRouter is the function that builds the router by arranging API routes.
-func setupRouter(apiRoutes routehttputilpkg.Routes) *mux.Router {
+// Every route is wrapped with the recovery middleware, followed by the given middlewares, so that a panic
+// of the given middlewares never leaves the client without a response. The panics of the routes themselves
+// are recovered inside their instrumentation, by the recovery middleware of their chain.
+// The handlers of the unmatched requests are wrapped as well, so that the CORS preflight requests,
+// which never match a route method, are answered. The versioned routes are mounted under their versions.
+func setupRouter(apiRoutes routehttputilpkg.Routes, middlewares ...adapterhttputilpkg.Adapter) *mux.Router {
 	router := mux.NewRouter()
 
+	middlewares = append([]adapterhttputilpkg.Adapter{recoverymiddlewarepkg.Recovery()}, middlewares...)
+
+	wrap := func(handlerFunc http.HandlerFunc) http.HandlerFunc {
+		return adapterhttputilpkg.AdaptFunc(handlerFunc).With(middlewares...)
+	}
+
 	methodNotAllowedHandler := handlerhttputilpkg.GetMethodNotAllowedHandler()
-	router.MethodNotAllowedHandler = methodNotAllowedHandler
+	router.MethodNotAllowedHandler = wrap(methodNotAllowedHandler.ServeHTTP)
 
 	notFoundHandler := handlerhttputilpkg.GetNotFoundHandler()
-	router.NotFoundHandler = notFoundHandler
+	router.NotFoundHandler = wrap(notFoundHandler.ServeHTTP)
+
+	// The versioned routes are mounted on a subrouter by version, such as /v1, which shares the handlers
+	// of the unmatched requests with the router.
+	subrouters := make(map[string]*mux.Router)
 
 	for _, apiRoute := range apiRoutes {
-		route := router.NewRoute()
+		parentRouter := router
+
+		if apiRoute.Version != "" {
+			subrouter, ok := subrouters[apiRoute.Version]
+			if !ok {
+				subrouter = router.PathPrefix("/" + apiRoute.Version).Subrouter()
+				subrouter.MethodNotAllowedHandler = router.MethodNotAllowedHandler
+				subrouter.NotFoundHandler = router.NotFoundHandler
+				subrouters[apiRoute.Version] = subrouter
+			}
+
+			parentRouter = subrouter
+		}
+
+		route := parentRouter.NewRoute()
 		route.Name(apiRoute.Name)
 		route.Methods(apiRoute.Method)
 
@@ -251,24 +978,43 @@ func setupRouter(apiRoutes routehttputilpkg.Routes) *mux.Router {
 			route.Path(apiRoute.Path)
 		}
 
-		route.HandlerFunc(apiRoute.HandlerFunc)
+		route.HandlerFunc(wrap(apiRoute.HandlerFunc))
 	}
 
 	return router
 }
 
-// waitForShutdown is the function that waits for a signal to shutdown the server.
-func waitForShutdown(server serverpkg.Server) {
+// stoppableServer is the interface of the servers that are stopped when the application shuts down.
+type stoppableServer interface {
+	Stop(ctx context.Context) error
+}
+
+// waitForShutdown is the function that waits for a signal to shutdown the servers.
+// The application drains first, so that the readiness fails while the requests still arriving are handled,
+// and then the servers are given a grace period to finish the ongoing requests.
+func waitForShutdown(drainer *serverpkg.Drainer, drainPeriod time.Duration, shutdownTimeout time.Duration,
+	servers ...stoppableServer) {
 	interruptChan := make(chan os.Signal, 1)
 
 	signal.Notify(interruptChan, os.Interrupt, syscall.SIGTERM)
 
 	<-interruptChan
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	slog.Info("server is draining", slog.Duration("period", drainPeriod))
+
+	// A second signal skips the rest of the drain period.
+	drainCtx, stopDraining := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	drainer.Drain(drainCtx, drainPeriod)
+	stopDraining()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
-	if err := server.Stop(ctx); err != nil && err != context.DeadlineExceeded {
-		log.Panicf("%s", err.Error())
+	slog.Info("server is shutting down", slog.Duration("timeout", shutdownTimeout))
+
+	for _, server := range servers {
+		if err := server.Stop(ctx); err != nil && err != context.DeadlineExceeded {
+			logPanic("failed to stop the server", err)
+		}
 	}
 }
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS users;
+
+-- Table users
+-- The UUIDs are stored in their textual form so that they are compared the same way as in postgres.
+CREATE TABLE IF NOT EXISTS users (
+    id CHAR(36) NOT NULL,
+    username VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(3) NULL DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) NULL DEFAULT CURRENT_TIMESTAMP(3),
+    CONSTRAINT users_pkey PRIMARY KEY (id),
+    CONSTRAINT users_username_key UNIQUE (username)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS logins;
+
+-- Table logins
+CREATE TABLE IF NOT EXISTS logins (
+    id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    username VARCHAR(255) NOT NULL,
+    password TEXT NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP(3) NULL DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) NULL DEFAULT CURRENT_TIMESTAMP(3),
+    CONSTRAINT logins_pkey PRIMARY KEY (id),
+    CONSTRAINT logins_user_id_key UNIQUE (user_id),
+    CONSTRAINT logins_user_id_users_id_foreign FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS auths;
+
+-- Table auths
+CREATE TABLE IF NOT EXISTS auths (
+    id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    created_at TIMESTAMP(3) NULL DEFAULT CURRENT_TIMESTAMP(3),
+    CONSTRAINT auths_pkey PRIMARY KEY (id),
+    CONSTRAINT auths_user_id_key UNIQUE (user_id),
+    CONSTRAINT auths_user_id_users_id_foreign FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
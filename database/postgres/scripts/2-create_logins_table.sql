@@ -6,6 +6,7 @@ CREATE TABLE IF NOT EXISTS logins (
     user_id uuid NOT NULL,
     username text NOT NULL,
     password text NOT NULL,
+    version integer NOT NULL DEFAULT 1,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP,
     updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP,
     CONSTRAINT logins_pkey PRIMARY KEY (id),
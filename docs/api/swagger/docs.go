@@ -382,6 +382,9 @@ var doc = `{
             "properties": {
                 "error": {
                     "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
                 }
             }
         },
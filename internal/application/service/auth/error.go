@@ -0,0 +1,20 @@
+package auth
+
+const (
+	// CodeValidationFailed is the code of the error returned when the credentials or the passwords are not valid.
+	CodeValidationFailed = "VALIDATION_FAILED"
+	// CodeUsernameTaken is the code of the error returned when the username is already registered.
+	CodeUsernameTaken = "USERNAME_TAKEN"
+	// CodeUsernameNotRegistered is the code of the error returned when the username is not registered.
+	CodeUsernameNotRegistered = "USERNAME_NOT_REGISTERED"
+	// CodeAlreadyLoggedIn is the code of the error returned when the user is already logged in.
+	CodeAlreadyLoggedIn = "ALREADY_LOGGED_IN"
+	// CodeUserNotRegistered is the code of the error returned when the user who owns the token is not registered.
+	CodeUserNotRegistered = "USER_NOT_REGISTERED"
+	// CodeLoginVersionMismatch is the code of the error returned when the login was modified since the informed version.
+	CodeLoginVersionMismatch = "LOGIN_VERSION_MISMATCH"
+	// CodeWrongPassword is the code of the error returned when the current password does not match the registered one.
+	CodeWrongPassword = "WRONG_PASSWORD"
+	// CodeSamePassword is the code of the error returned when the new password is the same as the current one.
+	CodeSamePassword = "SAME_PASSWORD"
+)
@@ -1,6 +1,12 @@
 package auth
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/auth"
 	authdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/auth"
@@ -8,7 +14,11 @@ import (
 	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/user"
 	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
 	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	validatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator"
 	"gorm.io/gorm"
 )
@@ -44,25 +54,28 @@ func New(persistentAuthRepository authdatastorerepository.IRepository,
 }
 
 // Register is the function that registers the user to the system.
-func (a *Service) Register(credentials securitypkg.Credentials) (string, error) {
+func (a *Service) Register(ctx context.Context, credentials securitypkg.Credentials) (string, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.Register")
+	defer span.End()
+
 	if err := a.Validator.Validate(credentials); err != nil {
-		return "", customerror.BadRequest.New(err.Error())
+		return "", customerror.WithCode(customerror.BadRequest.Newf("%w", err), CodeValidationFailed)
 	}
 
-	login, err := a.LoginDatastoreRepository.GetByUsername(credentials.Username)
+	login, err := a.LoginDatastoreRepository.GetByUsername(ctx, credentials.Username)
 	if err != nil {
 		return "", err
 	}
 
 	if !login.IsEmpty() {
-		return "", customerror.Conflict.Newf("the username %s is already registered", credentials.Username)
+		return "", customerror.WithDetails(customerror.WithCode(customerror.Conflict.Newf("the username %s is already registered", credentials.Username), CodeUsernameTaken), "username", credentials.Username)
 	}
 
 	user := domainentity.User{
 		Username: credentials.Username,
 	}
 
-	newUser, err := a.UserDatastoreRepository.Create(user)
+	newUser, err := a.UserDatastoreRepository.Create(ctx, user)
 	if err != nil {
 		return "", err
 	}
@@ -73,7 +86,7 @@ func (a *Service) Register(credentials securitypkg.Credentials) (string, error)
 		Password: credentials.Password,
 	}
 
-	_, err = a.LoginDatastoreRepository.Create(login)
+	_, err = a.LoginDatastoreRepository.Create(ctx, login)
 	if err != nil {
 		return "", err
 	}
@@ -82,7 +95,7 @@ func (a *Service) Register(credentials securitypkg.Credentials) (string, error)
 		UserID: newUser.ID,
 	}
 
-	newAuth, err := a.AuthDatastoreRepository.Create(auth)
+	newAuth, err := a.AuthDatastoreRepository.Create(ctx, auth)
 	if err != nil {
 		return "", err
 	}
@@ -92,43 +105,63 @@ func (a *Service) Register(credentials securitypkg.Credentials) (string, error)
 		return "", err
 	}
 
+	// The sign-up is only counted once the database transaction that stores it is committed.
+	dbtrxmiddlewarepkg.AfterCommit(ctx, metricspkg.SignUpsTotal.Inc)
+
+	loggerpkg.FromContext(ctx).Info("user registered", slog.String("user_id", newUser.ID.String()))
+
 	return token, nil
 }
 
 // LogIn is the function that initializes the user access to the system.
-func (a *Service) LogIn(credentials securitypkg.Credentials) (string, error) {
+func (a *Service) LogIn(ctx context.Context, credentials securitypkg.Credentials) (string, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.LogIn")
+	defer span.End()
+
 	if err := a.Validator.Validate(credentials); err != nil {
-		return "", customerror.BadRequest.New(err.Error())
+		metricspkg.CountSignIn(metricspkg.SignInReasonInvalidInput)
+		return "", customerror.WithCode(customerror.BadRequest.Newf("%w", err), CodeValidationFailed)
 	}
 
-	login, err := a.LoginDatastoreRepository.GetByUsername(credentials.Username)
+	login, err := a.LoginDatastoreRepository.GetByUsername(ctx, credentials.Username)
 	if err != nil {
+		metricspkg.CountSignIn(metricspkg.SignInReasonInternalError)
 		return "", err
 	}
 
 	if login.IsEmpty() {
-		return "", customerror.NotFound.Newf("the username %s is not registered", credentials.Username)
+		metricspkg.CountSignIn(metricspkg.SignInReasonUnknownUser)
+		return "", customerror.WithDetails(customerror.WithCode(customerror.NotFound.Newf("the username %s is not registered", credentials.Username), CodeUsernameNotRegistered), "username", credentials.Username)
 	}
 
-	if err = a.Security.VerifyPasswords(login.Password, credentials.Password); err != nil {
+	if err = a.verifyPasswords(ctx, login.Password, credentials.Password); err != nil {
+		if customerror.GetType(err) == customerror.Unauthorized {
+			metricspkg.CountSignIn(metricspkg.SignInReasonInvalidPassword)
+		} else {
+			metricspkg.CountSignIn(metricspkg.SignInReasonInternalError)
+		}
+
 		return "", err
 	}
 
-	auth, err := a.AuthDatastoreRepository.GetByUserID(login.UserID.String())
+	auth, err := a.AuthDatastoreRepository.GetByUserID(ctx, login.UserID.String())
 	if err != nil {
+		metricspkg.CountSignIn(metricspkg.SignInReasonInternalError)
 		return "", err
 	}
 
 	if !auth.IsEmpty() {
-		return "", customerror.Newf("the user with username %s is already logged in", credentials.Username)
+		metricspkg.CountSignIn(metricspkg.SignInReasonAlreadyLoggedIn)
+		return "", customerror.WithDetails(customerror.WithCode(customerror.Conflict.Newf("the user with username %s is already logged in", credentials.Username), CodeAlreadyLoggedIn), "username", credentials.Username)
 	}
 
 	auth = domainentity.Auth{
 		UserID: login.UserID,
 	}
 
-	newAuth, err := a.AuthDatastoreRepository.Create(auth)
+	newAuth, err := a.AuthDatastoreRepository.Create(ctx, auth)
 	if err != nil {
+		metricspkg.CountSignIn(metricspkg.SignInReasonInternalError)
 		return "", err
 	}
 
@@ -136,64 +169,165 @@ func (a *Service) LogIn(credentials securitypkg.Credentials) (string, error) {
 
 	token, err := a.AuthN.CreateToken(auth, a.TokenExpTimeInSec)
 	if err != nil {
+		metricspkg.CountSignIn(metricspkg.SignInReasonInternalError)
 		return "", err
 	}
 
+	// The sign-in is only counted as successful once the database transaction that stores the auth is committed.
+	dbtrxmiddlewarepkg.AfterCommit(ctx, func() { metricspkg.CountSignIn("") })
+
+	loggerpkg.FromContext(ctx).Info("user logged in", slog.String("user_id", auth.UserID.String()), slog.String("auth_id", auth.ID.String()))
+
 	return token, nil
 }
 
 // RenewToken is the function that renews the token.
-func (a *Service) RenewToken(auth domainentity.Auth) (string, error) {
-	return a.AuthN.CreateToken(auth, a.TokenExpTimeInSec)
+func (a *Service) RenewToken(ctx context.Context, auth domainentity.Auth) (string, error) {
+	_, span := tracingpkg.Start(ctx, "AuthService.RenewToken")
+	defer span.End()
+
+	token, err := a.AuthN.CreateToken(auth, a.TokenExpTimeInSec)
+	if err != nil {
+		return "", err
+	}
+
+	metricspkg.TokenRenewalsTotal.Inc()
+
+	return token, nil
 }
 
-// ModifyPassword is the function that modifies the user's password.
-func (a *Service) ModifyPassword(id string, passwords securitypkg.Passwords) error {
+// GetLogin is the function that gets the login of a user, whose version is expected by the password modifications.
+func (a *Service) GetLogin(ctx context.Context, id string) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.GetLogin")
+	defer span.End()
+
+	if err := a.Validator.ValidateWithTags(id, "nonzero, uuid"); err != nil {
+		return domainentity.Login{}, customerror.WithCode(customerror.BadRequest.Newf("%w", validatorpkg.WithField(err, "user_id")), CodeValidationFailed)
+	}
+
+	login, err := a.LoginDatastoreRepository.GetByUserID(ctx, id)
+	if err != nil {
+		return domainentity.Login{}, err
+	}
+
+	if login.IsEmpty() {
+		return domainentity.Login{}, customerror.WithCode(customerror.NotFound.New("the user who owns this token is not registered"), CodeUserNotRegistered)
+	}
+
+	return login, nil
+}
+
+// ModifyPassword is the function that modifies the user's password and returns the new version of the login.
+// When versions are informed, the password is only modified if the login still has one of them.
+func (a *Service) ModifyPassword(ctx context.Context, id string, passwords securitypkg.Passwords, versions []int) (int, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.ModifyPassword")
+	defer span.End()
+
 	if err := a.Validator.ValidateWithTags(id, "nonzero, uuid"); err != nil {
-		return customerror.BadRequest.Newf("UserID: %s", err.Error())
+		return 0, customerror.WithCode(customerror.BadRequest.Newf("%w", validatorpkg.WithField(err, "user_id")), CodeValidationFailed)
 	}
 
 	if err := a.Validator.Validate(passwords); err != nil {
-		return customerror.BadRequest.New(err.Error())
+		return 0, customerror.WithCode(customerror.BadRequest.Newf("%w", err), CodeValidationFailed)
 	}
 
-	login, err := a.LoginDatastoreRepository.GetByUserID(id)
+	login, err := a.LoginDatastoreRepository.GetByUserID(ctx, id)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if login.IsEmpty() {
-		return customerror.NotFound.New("the user who owns this token is not registered")
+		return 0, customerror.WithCode(customerror.NotFound.New("the user who owns this token is not registered"), CodeUserNotRegistered)
+	}
+
+	if len(versions) > 0 && !slices.Contains(versions, login.Version) {
+		return 0, newLoginVersionMismatchError(versions)
 	}
 
-	if err = a.Security.VerifyPasswords(login.Password, passwords.CurrentPassword); err != nil {
+	if err = a.verifyPasswords(ctx, login.Password, passwords.CurrentPassword); err != nil {
 		if customerror.GetType(err) == customerror.Unauthorized {
-			return customerror.Unauthorized.New("the current password did not match the one already registered")
+			return 0, customerror.WithCode(customerror.Unauthorized.New("the current password did not match the one already registered"), CodeWrongPassword)
 		}
 
-		return err
+		return 0, err
 	}
 
 	if passwords.NewPassword == passwords.CurrentPassword {
-		return customerror.BadRequest.New("the new password is the same as the one currently registered")
+		return 0, customerror.WithCode(customerror.BadRequest.New("the new password is the same as the one currently registered"), CodeSamePassword)
 	}
 
 	login.Password = passwords.NewPassword
 
-	_, err = a.LoginDatastoreRepository.Update(login.ID.String(), login)
+	updatedLogin, err := a.LoginDatastoreRepository.Update(ctx, login.ID.String(), login)
+	if err != nil {
+		if len(versions) > 0 && customerror.GetType(err) == customerror.Conflict {
+			return 0, newLoginVersionMismatchError(versions)
+		}
+
+		return 0, err
+	}
+
+	loggerpkg.FromContext(ctx).Info("password modified", slog.String("user_id", id), slog.Int("version", updatedLogin.Version))
+
+	return updatedLogin.Version, nil
+}
+
+// newLoginVersionMismatchError is the function that builds the error returned when the login does not have
+// any of the informed versions. The version detail lists them the same way as the message, whose translations render it.
+func newLoginVersionMismatchError(versions []int) error {
+	versionTexts := make([]string, 0, len(versions))
+	for _, version := range versions {
+		versionTexts = append(versionTexts, fmt.Sprint(version))
+	}
+
+	version := strings.Join(versionTexts, ", ")
+
+	err := customerror.PreconditionFailed.Newf("the login was modified since version %s", version)
 
-	return err
+	return customerror.WithDetails(customerror.WithCode(err, CodeLoginVersionMismatch), "version", version)
 }
 
 // LogOut is the function that concludes the user access to the system.
-func (a *Service) LogOut(id string) error {
+func (a *Service) LogOut(ctx context.Context, id string) error {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.LogOut")
+	defer span.End()
+
 	if err := a.Validator.ValidateWithTags(id, "nonzero, uuid"); err != nil {
-		return customerror.BadRequest.New(err.Error())
+		return customerror.WithCode(customerror.BadRequest.Newf("%w", validatorpkg.WithField(err, "auth_id")), CodeValidationFailed)
+	}
+
+	if _, err := a.AuthDatastoreRepository.Delete(ctx, id); err != nil {
+		return err
 	}
 
-	_, err := a.AuthDatastoreRepository.Delete(id)
+	metricspkg.SignOutsTotal.Inc()
+
+	loggerpkg.FromContext(ctx).Info("user logged out", slog.String("auth_id", id))
+
+	return nil
+}
+
+// GetByUserIDs is the function that gets the auths, that is, the sessions of a set of users at once.
+func (a *Service) GetByUserIDs(ctx context.Context, userIDs []string) (domainentity.Auths, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthService.GetByUserIDs")
+	defer span.End()
+
+	auths, err := a.AuthDatastoreRepository.GetByUserIDs(ctx, userIDs)
+	if err != nil {
+		return domainentity.Auths{}, err
+	}
+
+	loggerpkg.FromContext(ctx).Debug("auths fetched", slog.Int("users", len(userIDs)), slog.Int("count", len(auths)))
+
+	return auths, nil
+}
+
+// verifyPasswords is the function that verifies the passwords within a span, since the bcrypt comparison is costly.
+func (a *Service) verifyPasswords(ctx context.Context, hashedPassword, password string) error {
+	_, span := tracingpkg.Start(ctx, "Security.VerifyPasswords")
+	defer span.End()
 
-	return err
+	return a.Security.VerifyPasswords(hashedPassword, password)
 }
 
 // WithDBTrx is the function that enables the service with database transaction.
@@ -0,0 +1,100 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v5"
+	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/auth"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/auth"
+	logindatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/login"
+	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	mockauth "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockauth"
+	mocksecuritypkg "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mocksecurity"
+	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetByUserIDs() {
+	ctx := context.Background()
+
+	userIDs := make([]string, 0)
+
+	auths := domainentity.Auths{}
+
+	returnArgs := ReturnArgs{}
+
+	errorType := customerror.NoType
+
+	tokenExpTimeInSec := fake.Number(2, 10)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheAuthsOfASetOfUsers",
+			SetUp: func(t *testing.T) {
+				userID := uuid.NewV4()
+				userIDs = []string{userID.String()}
+
+				args := map[string]interface{}{
+					"userID": userID,
+				}
+
+				auths = domainentity.Auths{domainentity.AuthFactory(args)}
+
+				returnArgs = ReturnArgs{
+					{auths, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfItIsNotPossibleToGetTheAuthsOfASetOfUsers",
+			SetUp: func(t *testing.T) {
+				userIDs = []string{uuid.NewV4().String()}
+
+				returnArgs = ReturnArgs{
+					{domainentity.Auths{}, customerror.New("failed")},
+				}
+
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentAuthRepository := new(authdatastoremockrepository.Repository)
+			persistentAuthRepository.On("GetByUserIDs", mock.Anything, userIDs).Return(returnArgs[0]...)
+
+			persistentUserRepository := new(userdatastoremockrepository.Repository)
+
+			persistentLoginRepository := new(logindatastoremockrepository.Repository)
+
+			validator := new(mockvalidator.Validator)
+			authN := new(mockauth.Auth)
+			security := new(mocksecuritypkg.Security)
+
+			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
+				authN, security, validator, tokenExpTimeInSec)
+
+			returnedAuths, err := authService.GetByUserIDs(ctx, userIDs)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, auths, returnedAuths)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedAuths)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v5"
+	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/auth"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/auth"
+	logindatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/login"
+	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	mockauth "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockauth"
+	mocksecuritypkg "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mocksecurity"
+	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetLogin() {
+	ctx := context.Background()
+
+	id := ""
+
+	login := domainentity.Login{}
+
+	errorType := customerror.NoType
+
+	tokenExpTimeInSec := fake.Number(2, 10)
+
+	returnArgs := ReturnArgs{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheLogin",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+
+				userID, err := uuid.FromString(id)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   userID,
+					Username: fake.Username(),
+					Password: fake.Password(true, true, true, false, false, 8),
+					Version:  3,
+				}
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{login, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheIDIsNotValid",
+			SetUp: func(t *testing.T) {
+				id = ""
+
+				returnArgs = ReturnArgs{
+					{customerror.New("failed")},
+					{domainentity.Login{}, nil},
+				}
+
+				errorType = customerror.BadRequest
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfAnErrorOccursWhenGettingTheLoginByUserID",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{domainentity.Login{}, customerror.New("failed")},
+				}
+
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheLoginIsNotFound",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{domainentity.Login{}, nil},
+				}
+
+				errorType = customerror.NotFound
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			validator := new(mockvalidator.Validator)
+			validator.On("ValidateWithTags", id, "nonzero, uuid").Return(returnArgs[0]...)
+
+			persistentLoginRepository := new(logindatastoremockrepository.Repository)
+			persistentLoginRepository.On("GetByUserID", mock.Anything, id).Return(returnArgs[1]...)
+
+			persistentAuthRepository := new(authdatastoremockrepository.Repository)
+
+			persistentUserRepository := new(userdatastoremockrepository.Repository)
+
+			authN := new(mockauth.Auth)
+			security := new(mocksecuritypkg.Security)
+
+			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
+				authN, security, validator, tokenExpTimeInSec)
+
+			returnedLogin, err := authService.GetLogin(ctx, id)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, login, returnedLogin)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedLogin)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,9 +18,12 @@ import (
 	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (ts *TestSuite) TestLogIn() {
+	ctx := context.Background()
+
 	credentials := securitypkg.Credentials{}
 
 	login := domainentity.Login{}
@@ -231,7 +235,7 @@ func (ts *TestSuite) TestLogIn() {
 					{"", nil},
 				}
 
-				errorType = customerror.NoType
+				errorType = customerror.Conflict
 			},
 			WantError: true,
 			TearDown:  func(t *testing.T) {},
@@ -319,14 +323,14 @@ func (ts *TestSuite) TestLogIn() {
 			validator.On("Validate", credentials).Return(returnArgs[0]...)
 
 			persistentLoginRepository := new(logindatastoremockrepository.Repository)
-			persistentLoginRepository.On("GetByUsername", credentials.Username).Return(returnArgs[1]...)
+			persistentLoginRepository.On("GetByUsername", mock.Anything, credentials.Username).Return(returnArgs[1]...)
 
 			security := new(mocksecuritypkg.Security)
 			security.On("VerifyPasswords", login.Password, credentials.Password).Return(returnArgs[2]...)
 
 			persistentAuthRepository := new(authdatastoremockrepository.Repository)
-			persistentAuthRepository.On("GetByUserID", login.UserID.String()).Return(returnArgs[3]...)
-			persistentAuthRepository.On("Create", auth).Return(returnArgs[4]...)
+			persistentAuthRepository.On("GetByUserID", mock.Anything, login.UserID.String()).Return(returnArgs[3]...)
+			persistentAuthRepository.On("Create", mock.Anything, auth).Return(returnArgs[4]...)
 
 			authN := new(mockauth.Auth)
 			authN.On("CreateToken", newAuth, tokenExpTimeInSec).Return(returnArgs[5]...)
@@ -336,7 +340,7 @@ func (ts *TestSuite) TestLogIn() {
 			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 				authN, security, validator, tokenExpTimeInSec)
 
-			returnedToken, err := authService.LogIn(credentials)
+			returnedToken, err := authService.LogIn(ctx, credentials)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
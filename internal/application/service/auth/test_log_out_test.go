@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,9 +17,12 @@ import (
 	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (ts *TestSuite) TestLogOut() {
+	ctx := context.Background()
+
 	id := ""
 
 	errorType := customerror.NoType
@@ -89,7 +93,7 @@ func (ts *TestSuite) TestLogOut() {
 			validator.On("ValidateWithTags", id, "nonzero, uuid").Return(returnArgs[0]...)
 
 			persistentAuthRepository := new(authdatastoremockrepository.Repository)
-			persistentAuthRepository.On("Delete", id).Return(returnArgs[1]...)
+			persistentAuthRepository.On("Delete", mock.Anything, id).Return(returnArgs[1]...)
 
 			persistentUserRepository := new(userdatastoremockrepository.Repository)
 
@@ -101,7 +105,7 @@ func (ts *TestSuite) TestLogOut() {
 			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 				authN, security, validator, tokenExpTimeInSec)
 
-			err := authService.LogOut(id)
+			err := authService.LogOut(ctx, id)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
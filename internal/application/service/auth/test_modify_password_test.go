@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -11,15 +12,19 @@ import (
 	logindatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/login"
 	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
 	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
 	mockauth "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockauth"
 	mocksecuritypkg "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mocksecurity"
 	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (ts *TestSuite) TestModifyPassword() {
+	ctx := context.Background()
+
 	id := ""
 
 	passwords := securitypkg.Passwords{}
@@ -28,12 +33,21 @@ func (ts *TestSuite) TestModifyPassword() {
 
 	updatedLogin := domainentity.Login{}
 
+	versions := []int(nil)
+
+	newVersion := 0
+
 	errorType := customerror.NoType
 
 	tokenExpTimeInSec := fake.Number(2, 10)
 
 	returnArgs := ReturnArgs{}
 
+	catalog, catalogErr := i18npkg.New()
+	assert.Nil(ts.T(), catalogErr, fmt.Sprintf("Unexpected error: %v", catalogErr))
+
+	localizedDetail := ""
+
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInModifyingThePassword",
@@ -50,12 +64,15 @@ func (ts *TestSuite) TestModifyPassword() {
 					UserID:   userID,
 					Username: username,
 					Password: passwords.CurrentPassword,
+					Version:  1,
 				}
 
 				updatedLogin = login
 				updatedLogin.Password = passwords.NewPassword
 
 				newLogin := updatedLogin
+				newLogin.Version = 2
+				newVersion = 2
 
 				returnArgs = ReturnArgs{
 					{nil},
@@ -292,6 +309,133 @@ func (ts *TestSuite) TestModifyPassword() {
 			},
 			WantError: true,
 		},
+		{
+			Context: "ItShouldSucceedInModifyingThePasswordIfTheLoginWasNotModifiedSinceTheInformedVersion",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+				passwords = securitypkg.PasswordsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: fake.Username(),
+					Password: passwords.CurrentPassword,
+					Version:  3,
+				}
+
+				updatedLogin = login
+				updatedLogin.Password = passwords.NewPassword
+
+				newLogin := updatedLogin
+				newLogin.Version = 4
+
+				versions = []int{3}
+				newVersion = 4
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{nil},
+					{login, nil},
+					{nil},
+					{newLogin, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInModifyingThePasswordIfTheLoginHasOneOfTheInformedVersions",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+				passwords = securitypkg.PasswordsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: fake.Username(),
+					Password: passwords.CurrentPassword,
+					Version:  3,
+				}
+
+				updatedLogin = login
+				updatedLogin.Password = passwords.NewPassword
+
+				newLogin := updatedLogin
+				newLogin.Version = 4
+
+				versions = []int{2, 3}
+				newVersion = 4
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{nil},
+					{login, nil},
+					{nil},
+					{newLogin, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheLoginWasModifiedSinceTheInformedVersion",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+				passwords = securitypkg.PasswordsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: fake.Username(),
+					Password: passwords.CurrentPassword,
+					Version:  4,
+				}
+
+				versions = []int{2, 3}
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{nil},
+					{login, nil},
+					{nil},
+					{domainentity.Login{}, nil},
+				}
+
+				errorType = customerror.PreconditionFailed
+				localizedDetail = "el inicio de sesión fue modificado desde la versión 2, 3"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheLoginIsModifiedConcurrentlyWhenUpdatingItSinceTheInformedVersion",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4().String()
+				passwords = securitypkg.PasswordsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: fake.Username(),
+					Password: passwords.CurrentPassword,
+					Version:  3,
+				}
+
+				updatedLogin = login
+				updatedLogin.Password = passwords.NewPassword
+
+				versions = []int{3}
+
+				returnArgs = ReturnArgs{
+					{nil},
+					{nil},
+					{login, nil},
+					{nil},
+					{domainentity.Login{}, customerror.Conflict.New("failed")},
+				}
+
+				errorType = customerror.PreconditionFailed
+				localizedDetail = "el inicio de sesión fue modificado desde la versión 3"
+			},
+			WantError: true,
+		},
 	}
 
 	for _, tc := range ts.Cases {
@@ -303,12 +447,12 @@ func (ts *TestSuite) TestModifyPassword() {
 			validator.On("Validate", passwords).Return(returnArgs[1]...)
 
 			persistentLoginRepository := new(logindatastoremockrepository.Repository)
-			persistentLoginRepository.On("GetByUserID", id).Return(returnArgs[2]...)
+			persistentLoginRepository.On("GetByUserID", mock.Anything, id).Return(returnArgs[2]...)
 
 			security := new(mocksecuritypkg.Security)
 			security.On("VerifyPasswords", login.Password, passwords.CurrentPassword).Return(returnArgs[3]...)
 
-			persistentLoginRepository.On("Update", updatedLogin.ID.String(), updatedLogin).Return(returnArgs[4]...)
+			persistentLoginRepository.On("Update", mock.Anything, updatedLogin.ID.String(), updatedLogin).Return(returnArgs[4]...)
 
 			persistentAuthRepository := new(authdatastoremockrepository.Repository)
 
@@ -319,13 +463,21 @@ func (ts *TestSuite) TestModifyPassword() {
 			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 				authN, security, validator, tokenExpTimeInSec)
 
-			err := authService.ModifyPassword(id, passwords)
+			returnedVersion, err := authService.ModifyPassword(ctx, id, passwords, versions)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, newVersion, returnedVersion)
 			} else {
 				assert.NotNil(t, err, "Predicted error lost.")
 				assert.Equal(t, errorType, customerror.GetType(err))
+
+				// The details of the error fill the parameters of the translations of its code.
+				if customerror.GetCode(err) == authservice.CodeLoginVersionMismatch {
+					detail, ok := catalog.Message(i18npkg.Spanish, customerror.GetCode(err), customerror.GetDetails(err))
+					assert.True(t, ok, "The message of the code could not be translated.")
+					assert.Equal(t, localizedDetail, detail)
+				}
 			}
 		})
 	}
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -19,9 +20,12 @@ import (
 	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (ts *TestSuite) TestRegister() {
+	ctx := context.Background()
+
 	credentials := security.Credentials{}
 
 	user := domainentity.User{}
@@ -348,15 +352,15 @@ func (ts *TestSuite) TestRegister() {
 			validator.On("Validate", credentials).Return(returnArgs[0]...)
 
 			persistentLoginRepository := new(logindatastoremockrepository.Repository)
-			persistentLoginRepository.On("GetByUsername", credentials.Username).Return(returnArgs[1]...)
+			persistentLoginRepository.On("GetByUsername", mock.Anything, credentials.Username).Return(returnArgs[1]...)
 
 			persistentUserRepository := new(userdatastoremockrepository.Repository)
-			persistentUserRepository.On("Create", user).Return(returnArgs[2]...)
+			persistentUserRepository.On("Create", mock.Anything, user).Return(returnArgs[2]...)
 
-			persistentLoginRepository.On("Create", login).Return(returnArgs[3]...)
+			persistentLoginRepository.On("Create", mock.Anything, login).Return(returnArgs[3]...)
 
 			persistentAuthRepository := new(authdatastoremockrepository.Repository)
-			persistentAuthRepository.On("Create", auth).Return(returnArgs[4]...)
+			persistentAuthRepository.On("Create", mock.Anything, auth).Return(returnArgs[4]...)
 
 			authN := new(mockauth.Auth)
 			authN.On("CreateToken", newAuth, tokenExpTimeInSec).Return(returnArgs[5]...)
@@ -366,7 +370,7 @@ func (ts *TestSuite) TestRegister() {
 			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 				authN, security, validator, tokenExpTimeInSec)
 
-			returnedToken, err := authService.Register(credentials)
+			returnedToken, err := authService.Register(ctx, credentials)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
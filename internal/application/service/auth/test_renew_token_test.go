@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -19,6 +20,8 @@ import (
 )
 
 func (ts *TestSuite) TestRenewToken() {
+	ctx := context.Background()
+
 	auth := domainentity.Auth{}
 
 	tokenExpTimeInSec := fake.Number(2, 10)
@@ -95,7 +98,7 @@ func (ts *TestSuite) TestRenewToken() {
 			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
 				authN, security, validator, tokenExpTimeInSec)
 
-			returnedToken, err := authService.RenewToken(auth)
+			returnedToken, err := authService.RenewToken(ctx, auth)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
@@ -1,24 +1,39 @@
 package healthcheck
 
 import (
+	"context"
+	"database/sql"
+
 	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/healthcheck"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
 	"gorm.io/gorm"
 )
 
 type Service struct {
-	DB *gorm.DB
+	DB         *gorm.DB
+	IsDraining func() bool
+	Registry   *healthpkg.Registry
 }
 
 // New is the factory function that encapsulates the implementation related to healthcheck service.
-func New(db *gorm.DB) healthcheckservice.IService {
+// The isDraining function reports whether the application is shutting down, and it can be nil.
+// The registry holds the checkers of the dependencies the readiness relies on, and it can be nil.
+func New(db *gorm.DB, isDraining func() bool, registry *healthpkg.Registry) healthcheckservice.IService {
 	return &Service{
-		DB: db,
+		DB:         db,
+		IsDraining: isDraining,
+		Registry:   registry,
 	}
 }
 
 // GetStatus is the function that verifies if the service has started up correctly and is ready to accept requests.
-// As a way of checking, it makes sure if the database connection is alive.
+// As a way of checking, it makes sure if the application is not shutting down and if the database connection is alive.
 func (s *Service) GetStatus() error {
+	if s.IsDraining != nil && s.IsDraining() {
+		return customerror.ServiceUnavailable.New("the service is shutting down")
+	}
+
 	db, err := s.DB.DB()
 	if err != nil {
 		return err
@@ -30,3 +45,22 @@ func (s *Service) GetStatus() error {
 
 	return nil
 }
+
+// GetDBStats is the function that gets the statistics of the database connection pool.
+func (s *Service) GetDBStats() (sql.DBStats, error) {
+	db, err := s.DB.DB()
+	if err != nil {
+		return sql.DBStats{}, err
+	}
+
+	return db.Stats(), nil
+}
+
+// GetHealth is the function that checks the health of the dependencies registered in the registry.
+func (s *Service) GetHealth(ctx context.Context) healthpkg.Report {
+	if s.Registry == nil {
+		return healthpkg.Report{Status: healthpkg.StatusPass}
+	}
+
+	return s.Registry.Check(ctx)
+}
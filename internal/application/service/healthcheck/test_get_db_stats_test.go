@@ -0,0 +1,61 @@
+package healthcheck_test
+
+import (
+	"fmt"
+	"testing"
+
+	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/healthcheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetDBStats() {
+	driver := "postgres"
+	db, _ := NewMockDB(driver)
+	connPool := db.ConnPool
+
+	maxOpenConnections := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheDBStats",
+			SetUp: func(t *testing.T) {
+				sqlDB, err := db.DB()
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+
+				maxOpenConnections = 5
+				sqlDB.SetMaxOpenConns(maxOpenConnections)
+			},
+			WantError: false,
+			TearDown:  func(t *testing.T) {},
+		},
+		{
+			Context: "ItShouldFailIfTheDBFunctionEvaluatesToAnError",
+			SetUp: func(t *testing.T) {
+				db.ConnPool = nil
+			},
+			WantError: true,
+			TearDown: func(t *testing.T) {
+				db.ConnPool = connPool
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			healthCheckService := healthcheckservice.New(db, nil, nil)
+
+			dbStats, err := healthCheckService.GetDBStats()
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, maxOpenConnections, dbStats.MaxOpenConnections)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+
+			tc.TearDown(t)
+		})
+	}
+}
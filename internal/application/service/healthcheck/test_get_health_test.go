@@ -0,0 +1,76 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/healthcheck"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetHealth() {
+	driver := "postgres"
+	db, mock := NewMockDB(driver)
+
+	var registry *healthpkg.Registry
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheHealth",
+			SetUp: func(t *testing.T) {
+				registry = healthpkg.NewRegistry(0)
+				registry.Register(datastorepkg.NewPingChecker(db))
+
+				mock.ExpectPing()
+			},
+			WantError: false,
+			TearDown:  func(t *testing.T) {},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheHealthWithoutARegistry",
+			SetUp: func(t *testing.T) {
+				registry = nil
+			},
+			WantError: false,
+			TearDown:  func(t *testing.T) {},
+		},
+		{
+			Context: "ItShouldFailIfThePingCommandEvaluatesToAnError",
+			SetUp: func(t *testing.T) {
+				registry = healthpkg.NewRegistry(0)
+				registry.Register(datastorepkg.NewPingChecker(db))
+
+				mock.ExpectPing().
+					WillReturnError(customerror.New("failed"))
+			},
+			WantError: true,
+			TearDown:  func(t *testing.T) {},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			healthCheckService := healthcheckservice.New(db, nil, registry)
+
+			report := healthCheckService.GetHealth(context.Background())
+
+			if !tc.WantError {
+				assert.True(t, report.IsHealthy())
+			} else {
+				assert.False(t, report.IsHealthy())
+				assert.Equal(t, "failed", report.Components[0].Error)
+			}
+
+			err := mock.ExpectationsWereMet()
+			assert.Nil(ts.T(), err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+
+			tc.TearDown(t)
+		})
+	}
+}
@@ -16,6 +16,8 @@ func (ts *TestSuite) TestGetStatus() {
 
 	errorType := customerror.NoType
 
+	isDraining := false
+
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInGettingTheStatus",
@@ -25,6 +27,18 @@ func (ts *TestSuite) TestGetStatus() {
 			WantError: false,
 			TearDown:  func(t *testing.T) {},
 		},
+		{
+			Context: "ItShouldFailIfTheServiceIsDraining",
+			SetUp: func(t *testing.T) {
+				isDraining = true
+
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: true,
+			TearDown: func(t *testing.T) {
+				isDraining = false
+			},
+		},
 		{
 			Context: "ItShouldFailIfTheDBFunctionEvaluatesToAnError",
 			SetUp: func(t *testing.T) {
@@ -54,7 +68,7 @@ func (ts *TestSuite) TestGetStatus() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			healthCheckService := healthcheckservice.New(db)
+			healthCheckService := healthcheckservice.New(db, func() bool { return isDraining }, nil)
 
 			err := healthCheckService.GetStatus()
 
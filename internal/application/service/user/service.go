@@ -1,9 +1,14 @@
 package user
 
 import (
+	"context"
+	"log/slog"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/user"
 	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/user"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	validatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator"
 	"gorm.io/gorm"
 )
@@ -21,12 +26,48 @@ func New(persistentUserRepository userdatastorerepository.IRepository, validator
 }
 
 // GetAll is the function that deals with the user repository for getting all users.
-func (u *Service) GetAll() (domainentity.Users, error) {
-	users, err := u.UserDatastoreRepository.GetAll()
+func (u *Service) GetAll(ctx context.Context) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserService.GetAll")
+	defer span.End()
+
+	users, err := u.UserDatastoreRepository.GetAll(ctx)
+	if err != nil {
+		return domainentity.Users{}, err
+	}
+
+	loggerpkg.FromContext(ctx).Debug("users fetched", slog.Int("count", len(users)))
+
+	return users, nil
+}
+
+// GetByIDs is the function that deals with the user repository for getting the users of a set of ids at once.
+func (u *Service) GetByIDs(ctx context.Context, ids []string) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserService.GetByIDs")
+	defer span.End()
+
+	users, err := u.UserDatastoreRepository.GetByIDs(ctx, ids)
 	if err != nil {
 		return domainentity.Users{}, err
 	}
 
+	loggerpkg.FromContext(ctx).Debug("users fetched", slog.Int("ids", len(ids)), slog.Int("count", len(users)))
+
+	return users, nil
+}
+
+// GetPage is the function that deals with the user repository for getting a page of users,
+// starting after the user with the given id.
+func (u *Service) GetPage(ctx context.Context, afterID string, limit int) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserService.GetPage")
+	defer span.End()
+
+	users, err := u.UserDatastoreRepository.GetPage(ctx, afterID, limit)
+	if err != nil {
+		return domainentity.Users{}, err
+	}
+
+	loggerpkg.FromContext(ctx).Debug("users fetched", slog.Int("count", len(users)))
+
 	return users, nil
 }
 
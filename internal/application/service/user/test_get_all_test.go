@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -10,9 +11,12 @@ import (
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
 	"github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (ts *TestSuite) TestGetAll() {
+	ctx := context.Background()
+
 	user := domainentity.User{}
 
 	returnArgs := ReturnArgs{}
@@ -49,13 +53,13 @@ func (ts *TestSuite) TestGetAll() {
 			tc.SetUp(t)
 
 			persistentUserRepository := new(userdatastoremockrepository.Repository)
-			persistentUserRepository.On("GetAll").Return(returnArgs[0]...)
+			persistentUserRepository.On("GetAll", mock.Anything).Return(returnArgs[0]...)
 
 			validator := new(mockvalidator.Validator)
 
 			userService := userservice.New(persistentUserRepository, validator)
 
-			returnedUsers, err := userService.GetAll()
+			returnedUsers, err := userService.GetAll(ctx)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
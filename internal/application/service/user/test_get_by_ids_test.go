@@ -0,0 +1,78 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/user"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetByIDs() {
+	ctx := context.Background()
+
+	ids := make([]string, 0)
+
+	users := domainentity.Users{}
+
+	returnArgs := ReturnArgs{}
+
+	errorType := customerror.NoType
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheUsersOfASetOfIDs",
+			SetUp: func(t *testing.T) {
+				user := domainentity.UserFactory(nil)
+				ids = []string{user.ID.String()}
+				users = domainentity.Users{user}
+
+				returnArgs = ReturnArgs{
+					{users, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfItIsNotPossibleToGetTheUsersOfASetOfIDs",
+			SetUp: func(t *testing.T) {
+				returnArgs = ReturnArgs{
+					{domainentity.Users{}, customerror.New("failed")},
+				}
+
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentUserRepository := new(userdatastoremockrepository.Repository)
+			persistentUserRepository.On("GetByIDs", mock.Anything, ids).Return(returnArgs[0]...)
+
+			validator := new(mockvalidator.Validator)
+
+			userService := userservice.New(persistentUserRepository, validator)
+
+			returnedUsers, err := userService.GetByIDs(ctx, ids)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, users, returnedUsers)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedUsers)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/user"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetPage() {
+	ctx := context.Background()
+
+	afterID := uuid.NewV4().String()
+
+	limit := 10
+
+	user := domainentity.User{}
+
+	returnArgs := ReturnArgs{}
+
+	errorType := customerror.NoType
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingAPageOfUsers",
+			SetUp: func(t *testing.T) {
+				user = domainentity.UserFactory(nil)
+
+				returnArgs = ReturnArgs{
+					{domainentity.Users{user}, nil},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfItIsNotPossibleToGetAPageOfUsers",
+			SetUp: func(t *testing.T) {
+				returnArgs = ReturnArgs{
+					{domainentity.Users{}, customerror.ServiceUnavailable.New("failed")},
+				}
+
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentUserRepository := new(userdatastoremockrepository.Repository)
+			persistentUserRepository.On("GetPage", mock.Anything, afterID, limit).Return(returnArgs[0]...)
+
+			validator := new(mockvalidator.Validator)
+
+			userService := userservice.New(persistentUserRepository, validator)
+
+			returnedUsers, err := userService.GetPage(ctx, afterID, limit)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, domainentity.Users{user}, returnedUsers)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedUsers)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ type Auth struct {
 	UserID uuid.UUID
 }
 
+// Auths is a slice of Auth.
+type Auths []Auth
+
 // IsEmpty is the function that checks if auth's domain entity is empty.
 func (a Auth) IsEmpty() bool {
 	return a == Auth{}
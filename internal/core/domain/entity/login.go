@@ -10,6 +10,7 @@ type Login struct {
 	UserID   uuid.UUID
 	Username string `create:"nonzero, username" update:"username"`
 	Password string `create:"nonzero, password" update:"password"`
+	Version  int
 }
 
 // IsEmpty is the function that checks if login's database entity is empty.
@@ -42,6 +42,14 @@ func LoginFactory(args map[string]interface{}) Login {
 		}
 
 		return password, nil
+	}).Attr("Version", func(fArgs factory.Args) (interface{}, error) {
+		version := 0
+
+		if val, ok := args["version"]; ok {
+			version = val.(int)
+		}
+
+		return version, nil
 	})
 
 	return loginFactory.MustCreate().(Login)
@@ -3,6 +3,8 @@
 package auth
 
 import (
+	context "context"
+
 	entity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	auth "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/auth"
 
@@ -18,20 +20,64 @@ type Service struct {
 	mock.Mock
 }
 
-// LogIn provides a mock function with given fields: credentials
-func (_m *Service) LogIn(credentials security.Credentials) (string, error) {
-	ret := _m.Called(credentials)
+// GetByUserIDs provides a mock function with given fields: ctx, userIDs
+func (_m *Service) GetByUserIDs(ctx context.Context, userIDs []string) (entity.Auths, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	var r0 entity.Auths
+	if rf, ok := ret.Get(0).(func(context.Context, []string) entity.Auths); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Auths)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLogin provides a mock function with given fields: ctx, id
+func (_m *Service) GetLogin(ctx context.Context, id string) (entity.Login, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Login
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Login); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Login)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogIn provides a mock function with given fields: ctx, credentials
+func (_m *Service) LogIn(ctx context.Context, credentials security.Credentials) (string, error) {
+	ret := _m.Called(ctx, credentials)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(security.Credentials) string); ok {
-		r0 = rf(credentials)
+	if rf, ok := ret.Get(0).(func(context.Context, security.Credentials) string); ok {
+		r0 = rf(ctx, credentials)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(security.Credentials) error); ok {
-		r1 = rf(credentials)
+	if rf, ok := ret.Get(1).(func(context.Context, security.Credentials) error); ok {
+		r1 = rf(ctx, credentials)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -39,13 +85,13 @@ func (_m *Service) LogIn(credentials security.Credentials) (string, error) {
 	return r0, r1
 }
 
-// LogOut provides a mock function with given fields: id
-func (_m *Service) LogOut(id string) error {
-	ret := _m.Called(id)
+// LogOut provides a mock function with given fields: ctx, id
+func (_m *Service) LogOut(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -53,34 +99,41 @@ func (_m *Service) LogOut(id string) error {
 	return r0
 }
 
-// ModifyPassword provides a mock function with given fields: id, passwords
-func (_m *Service) ModifyPassword(id string, passwords security.Passwords) error {
-	ret := _m.Called(id, passwords)
+// ModifyPassword provides a mock function with given fields: ctx, id, passwords, versions
+func (_m *Service) ModifyPassword(ctx context.Context, id string, passwords security.Passwords, versions []int) (int, error) {
+	ret := _m.Called(ctx, id, passwords, versions)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, security.Passwords) error); ok {
-		r0 = rf(id, passwords)
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string, security.Passwords, []int) int); ok {
+		r0 = rf(ctx, id, passwords, versions)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, security.Passwords, []int) error); ok {
+		r1 = rf(ctx, id, passwords, versions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Register provides a mock function with given fields: credentials
-func (_m *Service) Register(credentials security.Credentials) (string, error) {
-	ret := _m.Called(credentials)
+// Register provides a mock function with given fields: ctx, credentials
+func (_m *Service) Register(ctx context.Context, credentials security.Credentials) (string, error) {
+	ret := _m.Called(ctx, credentials)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(security.Credentials) string); ok {
-		r0 = rf(credentials)
+	if rf, ok := ret.Get(0).(func(context.Context, security.Credentials) string); ok {
+		r0 = rf(ctx, credentials)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(security.Credentials) error); ok {
-		r1 = rf(credentials)
+	if rf, ok := ret.Get(1).(func(context.Context, security.Credentials) error); ok {
+		r1 = rf(ctx, credentials)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,20 +141,20 @@ func (_m *Service) Register(credentials security.Credentials) (string, error) {
 	return r0, r1
 }
 
-// RenewToken provides a mock function with given fields: _a0
-func (_m *Service) RenewToken(_a0 entity.Auth) (string, error) {
-	ret := _m.Called(_a0)
+// RenewToken provides a mock function with given fields: ctx, _a0
+func (_m *Service) RenewToken(ctx context.Context, _a0 entity.Auth) (string, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(entity.Auth) string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Auth) string); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(entity.Auth) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Auth) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
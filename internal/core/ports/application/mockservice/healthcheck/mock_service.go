@@ -2,13 +2,55 @@
 
 package healthcheck
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	sql "database/sql"
+
+	health "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Service is an autogenerated mock type for the IService type
 type Service struct {
 	mock.Mock
 }
 
+// GetDBStats provides a mock function with given fields:
+func (_m *Service) GetDBStats() (sql.DBStats, error) {
+	ret := _m.Called()
+
+	var r0 sql.DBStats
+	if rf, ok := ret.Get(0).(func() sql.DBStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(sql.DBStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHealth provides a mock function with given fields: ctx
+func (_m *Service) GetHealth(ctx context.Context) health.Report {
+	ret := _m.Called(ctx)
+
+	var r0 health.Report
+	if rf, ok := ret.Get(0).(func(context.Context) health.Report); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(health.Report)
+	}
+
+	return r0
+}
+
 // GetStatus provides a mock function with given fields:
 func (_m *Service) GetStatus() error {
 	ret := _m.Called()
@@ -3,6 +3,8 @@
 package user
 
 import (
+	context "context"
+
 	entity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	gorm "gorm.io/gorm"
 
@@ -16,13 +18,59 @@ type Service struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *Service) GetAll() (entity.Users, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: ctx
+func (_m *Service) GetAll(ctx context.Context) (entity.Users, error) {
+	ret := _m.Called(ctx)
+
+	var r0 entity.Users
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Users); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Users)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *Service) GetByIDs(ctx context.Context, ids []string) (entity.Users, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 entity.Users
+	if rf, ok := ret.Get(0).(func(context.Context, []string) entity.Users); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Users)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPage provides a mock function with given fields: ctx, afterID, limit
+func (_m *Service) GetPage(ctx context.Context, afterID string, limit int) (entity.Users, error) {
+	ret := _m.Called(ctx, afterID, limit)
 
 	var r0 entity.Users
-	if rf, ok := ret.Get(0).(func() entity.Users); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) entity.Users); ok {
+		r0 = rf(ctx, afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(entity.Users)
@@ -30,8 +78,8 @@ func (_m *Service) GetAll() (entity.Users, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"context"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
 	"gorm.io/gorm"
@@ -8,10 +10,12 @@ import (
 
 // IService interface is a collection of function signatures that represents the auth's service contract.
 type IService interface {
-	Register(credentials securitypkg.Credentials) (string, error)
-	LogIn(credentials securitypkg.Credentials) (string, error)
-	RenewToken(auth domainentity.Auth) (string, error)
-	ModifyPassword(id string, passwords securitypkg.Passwords) error
-	LogOut(id string) error
+	Register(ctx context.Context, credentials securitypkg.Credentials) (string, error)
+	LogIn(ctx context.Context, credentials securitypkg.Credentials) (string, error)
+	RenewToken(ctx context.Context, auth domainentity.Auth) (string, error)
+	GetLogin(ctx context.Context, id string) (domainentity.Login, error)
+	ModifyPassword(ctx context.Context, id string, passwords securitypkg.Passwords, versions []int) (int, error)
+	LogOut(ctx context.Context, id string) error
+	GetByUserIDs(ctx context.Context, userIDs []string) (domainentity.Auths, error)
 	WithDBTrx(dbTrx *gorm.DB) IService
 }
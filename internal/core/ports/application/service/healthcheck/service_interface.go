@@ -1,6 +1,15 @@
 package healthcheck
 
+import (
+	"context"
+	"database/sql"
+
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+)
+
 // IService interface is a collection of function signatures that represents the healthcheck's service contract.
 type IService interface {
 	GetStatus() error
+	GetDBStats() (sql.DBStats, error)
+	GetHealth(ctx context.Context) healthpkg.Report
 }
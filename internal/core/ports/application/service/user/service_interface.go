@@ -1,12 +1,16 @@
 package user
 
 import (
+	"context"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	"gorm.io/gorm"
 )
 
 // IService interface is a collection of function signatures that represents the user's service contract.
 type IService interface {
-	GetAll() (domainentity.Users, error)
+	GetAll(ctx context.Context) (domainentity.Users, error)
+	GetByIDs(ctx context.Context, ids []string) (domainentity.Users, error)
+	GetPage(ctx context.Context, afterID string, limit int) (domainentity.Users, error)
 	WithDBTrx(dbTrx *gorm.DB) IService
 }
@@ -3,6 +3,8 @@
 package auth
 
 import (
+	context "context"
+
 	entity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	auth "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/auth"
 
@@ -16,20 +18,20 @@ type Repository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: _a0
-func (_m *Repository) Create(_a0 entity.Auth) (entity.Auth, error) {
-	ret := _m.Called(_a0)
+// Create provides a mock function with given fields: ctx, _a0
+func (_m *Repository) Create(ctx context.Context, _a0 entity.Auth) (entity.Auth, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 entity.Auth
-	if rf, ok := ret.Get(0).(func(entity.Auth) entity.Auth); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Auth) entity.Auth); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		r0 = ret.Get(0).(entity.Auth)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(entity.Auth) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Auth) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,20 +39,20 @@ func (_m *Repository) Create(_a0 entity.Auth) (entity.Auth, error) {
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *Repository) Delete(id string) (entity.Auth, error) {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id string) (entity.Auth, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 entity.Auth
-	if rf, ok := ret.Get(0).(func(string) entity.Auth); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Auth); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(entity.Auth)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,20 +60,43 @@ func (_m *Repository) Delete(id string) (entity.Auth, error) {
 	return r0, r1
 }
 
-// GetByUserID provides a mock function with given fields: userID
-func (_m *Repository) GetByUserID(userID string) (entity.Auth, error) {
-	ret := _m.Called(userID)
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetByUserID(ctx context.Context, userID string) (entity.Auth, error) {
+	ret := _m.Called(ctx, userID)
 
 	var r0 entity.Auth
-	if rf, ok := ret.Get(0).(func(string) entity.Auth); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Auth); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		r0 = ret.Get(0).(entity.Auth)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserIDs provides a mock function with given fields: ctx, userIDs
+func (_m *Repository) GetByUserIDs(ctx context.Context, userIDs []string) (entity.Auths, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	var r0 entity.Auths
+	if rf, ok := ret.Get(0).(func(context.Context, []string) entity.Auths); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Auths)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
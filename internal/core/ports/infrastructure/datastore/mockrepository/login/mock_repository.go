@@ -3,6 +3,8 @@
 package login
 
 import (
+	context "context"
+
 	entity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	gorm "gorm.io/gorm"
 
@@ -16,20 +18,20 @@ type Repository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: _a0
-func (_m *Repository) Create(_a0 entity.Login) (entity.Login, error) {
-	ret := _m.Called(_a0)
+// Create provides a mock function with given fields: ctx, _a0
+func (_m *Repository) Create(ctx context.Context, _a0 entity.Login) (entity.Login, error) {
+	ret := _m.Called(ctx, _a0)
 
 	var r0 entity.Login
-	if rf, ok := ret.Get(0).(func(entity.Login) entity.Login); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Login) entity.Login); ok {
+		r0 = rf(ctx, _a0)
 	} else {
 		r0 = ret.Get(0).(entity.Login)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(entity.Login) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Login) error); ok {
+		r1 = rf(ctx, _a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,20 +39,20 @@ func (_m *Repository) Create(_a0 entity.Login) (entity.Login, error) {
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *Repository) Delete(id string) (entity.Login, error) {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id string) (entity.Login, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 entity.Login
-	if rf, ok := ret.Get(0).(func(string) entity.Login); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Login); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(entity.Login)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,20 +60,20 @@ func (_m *Repository) Delete(id string) (entity.Login, error) {
 	return r0, r1
 }
 
-// GetByUserID provides a mock function with given fields: userID
-func (_m *Repository) GetByUserID(userID string) (entity.Login, error) {
-	ret := _m.Called(userID)
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetByUserID(ctx context.Context, userID string) (entity.Login, error) {
+	ret := _m.Called(ctx, userID)
 
 	var r0 entity.Login
-	if rf, ok := ret.Get(0).(func(string) entity.Login); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Login); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		r0 = ret.Get(0).(entity.Login)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,20 +81,20 @@ func (_m *Repository) GetByUserID(userID string) (entity.Login, error) {
 	return r0, r1
 }
 
-// GetByUsername provides a mock function with given fields: username
-func (_m *Repository) GetByUsername(username string) (entity.Login, error) {
-	ret := _m.Called(username)
+// GetByUsername provides a mock function with given fields: ctx, username
+func (_m *Repository) GetByUsername(ctx context.Context, username string) (entity.Login, error) {
+	ret := _m.Called(ctx, username)
 
 	var r0 entity.Login
-	if rf, ok := ret.Get(0).(func(string) entity.Login); ok {
-		r0 = rf(username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Login); ok {
+		r0 = rf(ctx, username)
 	} else {
 		r0 = ret.Get(0).(entity.Login)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(username)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,20 +102,20 @@ func (_m *Repository) GetByUsername(username string) (entity.Login, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, _a1
-func (_m *Repository) Update(id string, _a1 entity.Login) (entity.Login, error) {
-	ret := _m.Called(id, _a1)
+// Update provides a mock function with given fields: ctx, id, _a1
+func (_m *Repository) Update(ctx context.Context, id string, _a1 entity.Login) (entity.Login, error) {
+	ret := _m.Called(ctx, id, _a1)
 
 	var r0 entity.Login
-	if rf, ok := ret.Get(0).(func(string, entity.Login) entity.Login); ok {
-		r0 = rf(id, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Login) entity.Login); ok {
+		r0 = rf(ctx, id, _a1)
 	} else {
 		r0 = ret.Get(0).(entity.Login)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, entity.Login) error); ok {
-		r1 = rf(id, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Login) error); ok {
+		r1 = rf(ctx, id, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,14 +1,17 @@
 package auth
 
 import (
+	"context"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	"gorm.io/gorm"
 )
 
 // IRepository interface is a collection of function signatures that represents the auth's datastore repository contract.
 type IRepository interface {
-	Create(auth domainentity.Auth) (domainentity.Auth, error)
-	GetByUserID(userID string) (domainentity.Auth, error)
-	Delete(id string) (domainentity.Auth, error)
+	Create(ctx context.Context, auth domainentity.Auth) (domainentity.Auth, error)
+	GetByUserID(ctx context.Context, userID string) (domainentity.Auth, error)
+	GetByUserIDs(ctx context.Context, userIDs []string) (domainentity.Auths, error)
+	Delete(ctx context.Context, id string) (domainentity.Auth, error)
 	WithDBTrx(dbTrx *gorm.DB) IRepository
 }
@@ -1,16 +1,18 @@
 package login
 
 import (
+	"context"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	"gorm.io/gorm"
 )
 
 // IRepository interface is a collection of function signatures that represents the login's repository contract.
 type IRepository interface {
-	Create(login domainentity.Login) (domainentity.Login, error)
-	GetByUsername(username string) (domainentity.Login, error)
-	GetByUserID(userID string) (domainentity.Login, error)
-	Update(id string, login domainentity.Login) (domainentity.Login, error)
-	Delete(id string) (domainentity.Login, error)
+	Create(ctx context.Context, login domainentity.Login) (domainentity.Login, error)
+	GetByUsername(ctx context.Context, username string) (domainentity.Login, error)
+	GetByUserID(ctx context.Context, userID string) (domainentity.Login, error)
+	Update(ctx context.Context, id string, login domainentity.Login) (domainentity.Login, error)
+	Delete(ctx context.Context, id string) (domainentity.Login, error)
 	WithDBTrx(dbTrx *gorm.DB) IRepository
 }
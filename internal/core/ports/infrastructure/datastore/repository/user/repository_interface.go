@@ -1,13 +1,17 @@
 package user
 
 import (
+	"context"
+
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	"gorm.io/gorm"
 )
 
 // IRepository interface is a collection of function signatures that represents the user's datastore repository contract.
 type IRepository interface {
-	Create(user domainentity.User) (domainentity.User, error)
-	GetAll() (domainentity.Users, error)
+	Create(ctx context.Context, user domainentity.User) (domainentity.User, error)
+	GetAll(ctx context.Context) (domainentity.Users, error)
+	GetByIDs(ctx context.Context, ids []string) (domainentity.Users, error)
+	GetPage(ctx context.Context, afterID string, limit int) (domainentity.Users, error)
 	WithDBTrx(dbTrx *gorm.DB) IRepository
 }
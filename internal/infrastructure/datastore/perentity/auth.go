@@ -15,6 +15,9 @@ type Auth struct {
 	CreatedAt time.Time
 }
 
+// Auths is a slice of Auth.
+type Auths []Auth
+
 // BeforeCreate is a Gorm hook that is called before create an auth in the database.
 func (a *Auth) BeforeCreate(tx *gorm.DB) error {
 	a.ID = uuid.NewV4()
@@ -35,3 +38,14 @@ func (a *Auth) ToDomain() domainentity.Auth {
 		UserID: a.UserID,
 	}
 }
+
+// ToDomain is the function that returns a slice of domain model built using the model slice's data from database.
+func (as *Auths) ToDomain() domainentity.Auths {
+	auths := domainentity.Auths{}
+
+	for _, a := range *as {
+		auths = append(auths, a.ToDomain())
+	}
+
+	return auths
+}
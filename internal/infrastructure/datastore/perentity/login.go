@@ -5,6 +5,7 @@ import (
 
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	uuid "github.com/satori/go.uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
@@ -16,6 +17,7 @@ type Login struct {
 	UserID    uuid.UUID `gorm:"type:uuid;not null;unique" validate:"uuid"`
 	Username  string
 	Password  string
+	Version   int `gorm:"not null"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -28,10 +30,13 @@ func (l Login) IsEmpty() bool {
 // BeforeCreate is a Gorm hook that is called before creating a login in the datastore.
 func (l *Login) BeforeCreate(tx *gorm.DB) error {
 	l.ID = uuid.NewV4()
+	l.Version = 1
 
 	security := securitypkg.New()
 
+	_, span := tracingpkg.Start(tx.Statement.Context, "Security.HashPassword")
 	hashedPassword, err := security.HashPassword(l.Password, bcrypt.DefaultCost)
+	span.End()
 	if err != nil {
 		return err
 	}
@@ -45,7 +50,9 @@ func (l *Login) BeforeCreate(tx *gorm.DB) error {
 func (l *Login) BeforeUpdate(tx *gorm.DB) error {
 	security := securitypkg.New()
 
+	_, span := tracingpkg.Start(tx.Statement.Context, "Security.HashPassword")
 	hashedPassword, err := security.HashPassword(l.Password, bcrypt.DefaultCost)
+	span.End()
 	if err != nil {
 		return err
 	}
@@ -61,6 +68,7 @@ func (l *Login) FromDomain(login domainentity.Login) {
 	l.UserID = login.UserID
 	l.Username = login.Username
 	l.Password = login.Password
+	l.Version = login.Version
 }
 
 // ToDomain is the function that returns a domain model built using the model's data from datastore.
@@ -70,5 +78,6 @@ func (l *Login) ToDomain() domainentity.Login {
 		UserID:   l.UserID,
 		Username: l.Username,
 		Password: l.Password,
+		Version:  l.Version,
 	}
 }
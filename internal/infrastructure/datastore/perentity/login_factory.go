@@ -70,6 +70,14 @@ func LoginFactory(args map[string]interface{}) Login {
 		}
 
 		return updatedAt, nil
+	}).Attr("Version", func(fArgs factory.Args) (interface{}, error) {
+		version := 1
+
+		if val, ok := args["version"]; ok {
+			version = val.(int)
+		}
+
+		return version, nil
 	})
 
 	return loginFactory.MustCreate().(Login)
@@ -1,12 +1,14 @@
 package auth
 
 import (
-	"strings"
+	"context"
 
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	authdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/auth"
 	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	"gorm.io/gorm"
 )
 
@@ -25,17 +27,22 @@ func New(db *gorm.DB) authdatastorerepository.IRepository {
 }
 
 // Create is the function that creates an auth in the datastore.
-func (r *Repository) Create(auth domainentity.Auth) (domainentity.Auth, error) {
+func (r *Repository) Create(ctx context.Context, auth domainentity.Auth) (domainentity.Auth, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthRepository.Create")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentAuth := persistententity.Auth{}
 	persistentAuth.FromDomain(auth)
 
-	result := r.DB.Create(&persistentAuth)
+	result := db.Create(&persistentAuth)
 	if result.Error != nil {
-		if strings.Contains(result.Error.Error(), "auths_user_id_key") {
+		if datastorepkg.IsUniqueViolation(result.Error, "auths_user_id_key") {
 			persistentLogin := persistententity.Login{}
 
-			if result := r.DB.Find(&persistentLogin, "user_id=?", persistentAuth.UserID); result.Error != nil {
-				return domainentity.Auth{}, result.Error
+			if result := db.Find(&persistentLogin, "user_id=?", persistentAuth.UserID); result.Error != nil {
+				return domainentity.Auth{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 			}
 
 			if result.RowsAffected == 0 && persistentLogin.IsEmpty() {
@@ -45,38 +52,69 @@ func (r *Repository) Create(auth domainentity.Auth) (domainentity.Auth, error) {
 			return domainentity.Auth{}, customerror.Conflict.Newf("The user with id %s is already logged in", persistentAuth.UserID)
 		}
 
-		return domainentity.Auth{}, result.Error
+		return domainentity.Auth{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentAuth.ToDomain(), nil
 }
 
 // GetByUserID is the function that gets an auth by user id from the datastore.
-func (r *Repository) GetByUserID(userID string) (domainentity.Auth, error) {
+func (r *Repository) GetByUserID(ctx context.Context, userID string) (domainentity.Auth, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthRepository.GetByUserID")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentAuth := persistententity.Auth{}
 
-	if result := r.DB.Find(&persistentAuth, "user_id=?", userID); result.Error != nil {
-		return domainentity.Auth{}, result.Error
+	if result := db.Find(&persistentAuth, "user_id=?", userID); result.Error != nil {
+		return domainentity.Auth{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentAuth.ToDomain(), nil
 }
 
+// GetByUserIDs is the function that gets the auths of a set of users from the datastore in a single query,
+// so that the auths of many users are not fetched one by one.
+func (r *Repository) GetByUserIDs(ctx context.Context, userIDs []string) (domainentity.Auths, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthRepository.GetByUserIDs")
+	defer span.End()
+
+	if len(userIDs) == 0 {
+		return domainentity.Auths{}, nil
+	}
+
+	db := r.DB.WithContext(ctx)
+
+	persistentAuths := persistententity.Auths{}
+
+	if result := db.Order("id").Find(&persistentAuths, "user_id IN ?", userIDs); result.Error != nil {
+		return domainentity.Auths{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
+	}
+
+	return persistentAuths.ToDomain(), nil
+}
+
 // Delete is the function that deletes an auth by id from the datastore.
-func (r *Repository) Delete(id string) (domainentity.Auth, error) {
+func (r *Repository) Delete(ctx context.Context, id string) (domainentity.Auth, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthRepository.Delete")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentAuth := persistententity.Auth{}
 
-	result := r.DB.Find(&persistentAuth, "id=?", id)
+	result := db.Find(&persistentAuth, "id=?", id)
 	if result.Error != nil {
-		return domainentity.Auth{}, result.Error
+		return domainentity.Auth{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
 		return domainentity.Auth{}, customerror.NotFound.Newf("the auth with id %s was not found", id)
 	}
 
-	if result = r.DB.Delete(&persistentAuth); result.Error != nil {
-		return domainentity.Auth{}, result.Error
+	if result = db.Delete(&persistentAuth); result.Error != nil {
+		return domainentity.Auth{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
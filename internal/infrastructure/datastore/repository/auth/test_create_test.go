@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -15,7 +16,9 @@ import (
 )
 
 func (ts *TestSuite) TestCreate() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	auth := domainentity.Auth{}
@@ -24,9 +27,9 @@ func (ts *TestSuite) TestCreate() {
 
 	errorType := customerror.NoType
 
-	firstSqlQuery := `INSERT INTO "auths" ("id","user_id","created_at") VALUES ($1,$2,$3)`
+	firstSqlQuery := ts.SQL(`INSERT INTO "auths" ("id","user_id","created_at") VALUES ($1,$2,$3)`)
 
-	secondSqlQuery := `SELECT * FROM "logins" WHERE user_id=$1`
+	secondSqlQuery := ts.SQL(`SELECT * FROM "logins" WHERE user_id=$1`)
 
 	ts.Cases = Cases{
 		{
@@ -98,7 +101,7 @@ func (ts *TestSuite) TestCreate() {
 
 				mock.ExpectExec(regexp.QuoteMeta(firstSqlQuery)).
 					WithArgs(sqlmock.AnyArg(), auth.UserID, sqlmock.AnyArg()).
-					WillReturnError(customerror.Conflict.New("auths_user_id_key"))
+					WillReturnError(ts.UniqueViolationError("auths_user_id_key"))
 
 				mock.ExpectRollback()
 
@@ -136,7 +139,7 @@ func (ts *TestSuite) TestCreate() {
 
 				mock.ExpectExec(regexp.QuoteMeta(firstSqlQuery)).
 					WithArgs(sqlmock.AnyArg(), auth.UserID, sqlmock.AnyArg()).
-					WillReturnError(customerror.New("auths_user_id_key"))
+					WillReturnError(ts.UniqueViolationError("auths_user_id_key"))
 
 				mock.ExpectRollback()
 
@@ -164,7 +167,7 @@ func (ts *TestSuite) TestCreate() {
 
 				mock.ExpectExec(regexp.QuoteMeta(firstSqlQuery)).
 					WithArgs(sqlmock.AnyArg(), auth.UserID, sqlmock.AnyArg()).
-					WillReturnError(customerror.New("auths_user_id_key"))
+					WillReturnError(ts.UniqueViolationError("auths_user_id_key"))
 
 				mock.ExpectRollback()
 
@@ -184,7 +187,7 @@ func (ts *TestSuite) TestCreate() {
 
 			persistentAuthRepository := authdatastorerepository.New(db)
 
-			returnedAuth, err := persistentAuthRepository.Create(auth)
+			returnedAuth, err := persistentAuthRepository.Create(ctx, auth)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
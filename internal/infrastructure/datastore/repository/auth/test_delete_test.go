@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -15,7 +16,9 @@ import (
 )
 
 func (ts *TestSuite) TestDelete() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	var id uuid.UUID
@@ -24,9 +27,9 @@ func (ts *TestSuite) TestDelete() {
 
 	errorType := customerror.NoType
 
-	firstStmt := `SELECT * FROM "auths" WHERE id=$1`
+	firstStmt := ts.SQL(`SELECT * FROM "auths" WHERE id=$1`)
 
-	secondStmt := `DELETE FROM "auths" WHERE "auths"."id" = $1`
+	secondStmt := ts.SQL(`DELETE FROM "auths" WHERE "auths"."id" = $1`)
 
 	ts.Cases = Cases{
 		{
@@ -157,7 +160,7 @@ func (ts *TestSuite) TestDelete() {
 
 			persistentAuthRepository := authdatastorerepository.New(db)
 
-			returnedAuth, err := persistentAuthRepository.Delete(id.String())
+			returnedAuth, err := persistentAuthRepository.Delete(ctx, id.String())
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
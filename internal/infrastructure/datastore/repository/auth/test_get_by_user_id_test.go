@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -15,7 +16,9 @@ import (
 )
 
 func (ts *TestSuite) TestGetByUserID() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	var userID uuid.UUID
@@ -24,7 +27,7 @@ func (ts *TestSuite) TestGetByUserID() {
 
 	errorType := customerror.NoType
 
-	stmt := `SELECT * FROM "auths" WHERE user_id=$1`
+	stmt := ts.SQL(`SELECT * FROM "auths" WHERE user_id=$1`)
 
 	ts.Cases = Cases{
 		{
@@ -70,7 +73,7 @@ func (ts *TestSuite) TestGetByUserID() {
 
 			persistentAuthRepository := persistentAuthrepository.New(db)
 
-			returnedAuth, err := persistentAuthRepository.GetByUserID(userID.String())
+			returnedAuth, err := persistentAuthRepository.GetByUserID(ctx, userID.String())
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
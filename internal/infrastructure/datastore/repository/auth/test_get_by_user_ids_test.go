@@ -0,0 +1,100 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	persistentAuthrepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/auth"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetByUserIDs() {
+	ctx := context.Background()
+
+	driver := ts.Driver
+	db, mock := NewMockDB(driver)
+
+	userIDs := make([]string, 0)
+
+	auths := domainentity.Auths{}
+
+	errorType := customerror.NoType
+
+	stmt := ts.SQL(`SELECT * FROM "auths" WHERE user_id IN ($1,$2) ORDER BY id`)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheAuthsOfASetOfUsersInASingleQuery",
+			SetUp: func(t *testing.T) {
+				userID := uuid.NewV4()
+				userIDs = []string{userID.String(), uuid.NewV4().String()}
+
+				args := map[string]interface{}{
+					"userID": userID,
+				}
+
+				persistentAuth := persistententity.AuthFactory(args)
+				auths = domainentity.Auths{persistentAuth.ToDomain()}
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "created_at"}).
+					AddRow(persistentAuth.ID, persistentAuth.UserID, persistentAuth.CreatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(stmt)).
+					WithArgs(userIDs[0], userIDs[1]).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingNoAuthsWithoutQueryingIfNoUserIsInformed",
+			SetUp: func(t *testing.T) {
+				userIDs = []string{}
+				auths = domainentity.Auths{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfAnErrorOccursWhenFindingTheAuthsOfASetOfUsers",
+			SetUp: func(t *testing.T) {
+				userIDs = []string{uuid.NewV4().String(), uuid.NewV4().String()}
+
+				mock.ExpectQuery(regexp.QuoteMeta(stmt)).
+					WithArgs(userIDs[0], userIDs[1]).
+					WillReturnError(customerror.New("failed"))
+
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentAuthRepository := persistentAuthrepository.New(db)
+
+			returnedAuths, err := persistentAuthRepository.GetByUserIDs(ctx, userIDs)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, auths, returnedAuths)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedAuths)
+			}
+
+			err = mock.ExpectationsWereMet()
+			assert.Nil(ts.T(), err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
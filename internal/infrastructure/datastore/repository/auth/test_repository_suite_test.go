@@ -1,12 +1,18 @@
 package auth_test
 
 import (
+	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
-
+	mysqldriver "github.com/go-sql-driver/mysql"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -22,9 +28,12 @@ type Cases []Case
 
 type TestSuite struct {
 	suite.Suite
-	Cases Cases
+	Cases  Cases
+	Driver string
 }
 
+var placeholderRegexp = regexp.MustCompile(`\$[0-9]+`)
+
 func NewMockDB(driver string) (*gorm.DB, sqlmock.Sqlmock) {
 	errorMsg := "failed to open a stub database connection"
 
@@ -47,12 +56,20 @@ func NewMockDB(driver string) (*gorm.DB, sqlmock.Sqlmock) {
 
 	switch driver {
 	case "postgres":
-		db, err = gorm.Open(postgres.New(postgres.Config{
+		db, err = gorm.Open(datastorepkg.NewPostgresDialector(postgres.Config{
 			Conn: sqlDB,
 		}), &gorm.Config{})
 		if err != nil {
 			log.Panicf("%s: %s", errorMsg, err.Error())
 		}
+	case "mysql":
+		db, err = gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		if err != nil {
+			log.Panicf("%s: %s", errorMsg, err.Error())
+		}
 	}
 
 	if db == nil {
@@ -66,6 +83,40 @@ func NewMockDB(driver string) (*gorm.DB, sqlmock.Sqlmock) {
 	return db, mock
 }
 
-func TestRepositorySuite(t *testing.T) {
-	suite.Run(t, new(TestSuite))
+// SQL is the function that adapts a statement written with the quoting and the placeholders of postgres
+// to the dialect of the driver of the suite.
+func (ts *TestSuite) SQL(stmt string) string {
+	if ts.Driver != "mysql" {
+		return stmt
+	}
+
+	return placeholderRegexp.ReplaceAllString(strings.ReplaceAll(stmt, `"`, "`"), "?")
+}
+
+// UniqueViolationError is the function that builds the error the driver of the suite reports
+// when a unique constraint is violated.
+func (ts *TestSuite) UniqueViolationError(constraint string) error {
+	if ts.Driver == "mysql" {
+		return &mysqldriver.MySQLError{Number: 1062, Message: fmt.Sprintf("Duplicate entry 'value' for key '%s'", constraint)}
+	}
+
+	return &pgconn.PgError{Code: "23505", ConstraintName: constraint}
+}
+
+// ConnectionFailureError is the function that builds the error the driver of the suite reports
+// when the connection to the database is lost.
+func (ts *TestSuite) ConnectionFailureError() error {
+	if ts.Driver == "mysql" {
+		return mysqldriver.ErrInvalidConn
+	}
+
+	return &pgconn.PgError{Code: "08006"}
+}
+
+func TestPostgresRepositorySuite(t *testing.T) {
+	suite.Run(t, &TestSuite{Driver: "postgres"})
+}
+
+func TestMySQLRepositorySuite(t *testing.T) {
+	suite.Run(t, &TestSuite{Driver: "mysql"})
 }
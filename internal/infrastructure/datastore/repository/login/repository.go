@@ -1,12 +1,14 @@
 package login
 
 import (
-	"strings"
+	"context"
 
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	logindatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/login"
 	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	"gorm.io/gorm"
 )
 
@@ -25,59 +27,91 @@ func New(db *gorm.DB) logindatastorerepository.IRepository {
 }
 
 // Create is the function that creates a login in the database.
-func (r *Repository) Create(login domainentity.Login) (domainentity.Login, error) {
+func (r *Repository) Create(ctx context.Context, login domainentity.Login) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "LoginRepository.Create")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentLogin := persistententity.Login{}
 	persistentLogin.FromDomain(login)
 
-	if result := r.DB.Create(&persistentLogin); result.Error != nil {
-		if strings.Contains(result.Error.Error(), "logins_user_id_key") {
+	if result := db.Create(&persistentLogin); result.Error != nil {
+		if datastorepkg.IsUniqueViolation(result.Error, "logins_user_id_key") {
 			return domainentity.Login{}, customerror.Conflict.Newf("The user with id %s is already logged in", login.Username)
 		}
 
-		return domainentity.Login{}, result.Error
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentLogin.ToDomain(), nil
 }
 
 // GetByUsername is the function that gets a user by username from the database.
-func (r *Repository) GetByUsername(username string) (domainentity.Login, error) {
+func (r *Repository) GetByUsername(ctx context.Context, username string) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "LoginRepository.GetByUsername")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentLogin := persistententity.Login{}
 
-	if result := r.DB.Find(&persistentLogin, "username=?", username); result.Error != nil {
-		return domainentity.Login{}, result.Error
+	if result := db.Find(&persistentLogin, "username=?", username); result.Error != nil {
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentLogin.ToDomain(), nil
 }
 
 // GetByUsername is the function that gets a user by username from the database.
-func (r *Repository) GetByUserID(userID string) (domainentity.Login, error) {
+func (r *Repository) GetByUserID(ctx context.Context, userID string) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "LoginRepository.GetByUserID")
+	defer span.End()
+
+	// The login is read from the primary, since its version is the one expected by the next update.
+	db := datastorepkg.UsePrimary(r.DB.WithContext(ctx))
+
 	persistentLogin := persistententity.Login{}
 
-	if result := r.DB.Find(&persistentLogin, "user_id=?", userID); result.Error != nil {
-		return domainentity.Login{}, result.Error
+	if result := db.Find(&persistentLogin, "user_id=?", userID); result.Error != nil {
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentLogin.ToDomain(), nil
 }
 
 // Update is the function that updates a login by id in the database.
-func (r *Repository) Update(id string, login domainentity.Login) (domainentity.Login, error) {
+// The login is only updated if its version matches the one stored, which is then incremented.
+// The login is read back from the primary, since a replica may not have the update yet.
+func (r *Repository) Update(ctx context.Context, id string, login domainentity.Login) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "LoginRepository.Update")
+	defer span.End()
+
+	db := datastorepkg.UsePrimary(r.DB.WithContext(ctx))
+
 	persistentLogin := persistententity.Login{}
 	persistentLogin.FromDomain(login)
+	persistentLogin.Version = login.Version + 1
 
-	result := r.DB.Model(&persistentLogin).Where("id=?", id).Updates(&persistentLogin)
+	result := db.Model(&persistentLogin).Where("id=? AND version=?", id, login.Version).Updates(&persistentLogin)
 	if result.Error != nil {
-		return domainentity.Login{}, result.Error
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return domainentity.Login{}, customerror.NotFound.Newf("the login with id %s was not updated", id)
+		if result = db.Find(&persistententity.Login{}, "id=?", id); result.Error != nil {
+			return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return domainentity.Login{}, customerror.NotFound.Newf("the login with id %s was not found", id)
+		}
+
+		return domainentity.Login{}, customerror.Conflict.Newf("the login with id %s was modified since version %d", id, login.Version)
 	}
 
-	if result = r.DB.Find(&persistentLogin, "id=?", id); result.Error != nil {
-		return domainentity.Login{}, result.Error
+	if result = db.Find(&persistentLogin, "id=?", id); result.Error != nil {
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
@@ -88,20 +122,25 @@ func (r *Repository) Update(id string, login domainentity.Login) (domainentity.L
 }
 
 // Delete is the function that deletes a login by id from the database.
-func (r *Repository) Delete(id string) (domainentity.Login, error) {
+func (r *Repository) Delete(ctx context.Context, id string) (domainentity.Login, error) {
+	ctx, span := tracingpkg.Start(ctx, "LoginRepository.Delete")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentLogin := persistententity.Login{}
 
-	result := r.DB.Find(&persistentLogin, "id=?", id)
+	result := db.Find(&persistentLogin, "id=?", id)
 	if result.Error != nil {
-		return domainentity.Login{}, result.Error
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
 		return domainentity.Login{}, customerror.NotFound.Newf("the login with id %s was not found", id)
 	}
 
-	if result = r.DB.Delete(&persistentLogin); result.Error != nil {
-		return domainentity.Login{}, result.Error
+	if result = db.Delete(&persistentLogin); result.Error != nil {
+		return domainentity.Login{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	if result.RowsAffected == 0 {
@@ -1,6 +1,7 @@
 package login_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -15,7 +16,9 @@ import (
 )
 
 func (ts *TestSuite) TestCreate() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	login := domainentity.Login{}
@@ -24,7 +27,7 @@ func (ts *TestSuite) TestCreate() {
 
 	errorType := customerror.NoType
 
-	sqlQuery := `INSERT INTO "logins" ("id","user_id","username","password","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6)`
+	sqlQuery := ts.SQL(`INSERT INTO "logins" ("id","user_id","username","password","version","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7)`)
 
 	ts.Cases = Cases{
 		{
@@ -47,7 +50,7 @@ func (ts *TestSuite) TestCreate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
-					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectCommit()
@@ -66,7 +69,7 @@ func (ts *TestSuite) TestCreate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
-					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(customerror.New("failed"))
 
 				mock.ExpectRollback()
@@ -87,8 +90,8 @@ func (ts *TestSuite) TestCreate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
-					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
-					WillReturnError(customerror.Conflict.New("logins_user_id_key"))
+					WithArgs(sqlmock.AnyArg(), login.UserID, login.Username, sqlmock.AnyArg(), 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(ts.UniqueViolationError("logins_user_id_key"))
 
 				mock.ExpectRollback()
 
@@ -104,7 +107,7 @@ func (ts *TestSuite) TestCreate() {
 
 			persistentLoginRepository := logindatastorerepository.New(db)
 
-			returnedLogin, err := persistentLoginRepository.Create(login)
+			returnedLogin, err := persistentLoginRepository.Create(ctx, login)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
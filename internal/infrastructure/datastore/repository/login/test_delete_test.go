@@ -1,6 +1,7 @@
 package login_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -15,7 +16,9 @@ import (
 )
 
 func (ts *TestSuite) TestDelete() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	var id uuid.UUID
@@ -24,9 +27,9 @@ func (ts *TestSuite) TestDelete() {
 
 	errorType := customerror.NoType
 
-	firstStmt := `SELECT * FROM "logins" WHERE id=$1`
+	firstStmt := ts.SQL(`SELECT * FROM "logins" WHERE id=$1`)
 
-	secondStmt := `DELETE FROM "logins" WHERE "logins"."id" = $1`
+	secondStmt := ts.SQL(`DELETE FROM "logins" WHERE "logins"."id" = $1`)
 
 	ts.Cases = Cases{
 		{
@@ -157,7 +160,7 @@ func (ts *TestSuite) TestDelete() {
 
 			persistentLoginRepository := logindatastorerepository.New(db)
 
-			returnedLogin, err := persistentLoginRepository.Delete(id.String())
+			returnedLogin, err := persistentLoginRepository.Delete(ctx, id.String())
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
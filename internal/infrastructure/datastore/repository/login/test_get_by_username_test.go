@@ -1,6 +1,7 @@
 package login_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -16,7 +17,9 @@ import (
 )
 
 func (ts *TestSuite) TestGetByUsername() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	username := ""
@@ -25,7 +28,7 @@ func (ts *TestSuite) TestGetByUsername() {
 
 	errorType := customerror.NoType
 
-	stmt := `SELECT * FROM "logins" WHERE username=$1`
+	stmt := ts.SQL(`SELECT * FROM "logins" WHERE username=$1`)
 
 	ts.Cases = Cases{
 		{
@@ -91,7 +94,7 @@ func (ts *TestSuite) TestGetByUsername() {
 
 			persistentLoginRepository := logindatastorerepository.New(db)
 
-			returnedLogin, err := persistentLoginRepository.GetByUsername(username)
+			returnedLogin, err := persistentLoginRepository.GetByUsername(ctx, username)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
@@ -0,0 +1,182 @@
+package login_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	logindatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/login"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestReadFromPrimary() {
+	ctx := context.Background()
+
+	driver := ts.Driver
+
+	var db *gorm.DB
+	var primaryMock, replicaMock sqlmock.Sqlmock
+
+	var call func(repository *logindatastorerepository.Repository) (domainentity.Login, error)
+
+	var id uuid.UUID
+
+	login := domainentity.Login{}
+
+	updatedLogin := domainentity.Login{}
+
+	errorType := customerror.NoType
+
+	updateStmt := ts.SQL(`UPDATE "logins" SET "user_id"=$1,"username"=$2,"password"=$3,"version"=$4,"updated_at"=$5 WHERE id=$6 AND version=$7`)
+
+	selectByIDStmt := ts.SQL(`SELECT * FROM "logins" WHERE id=$1`)
+
+	selectByUserIDStmt := ts.SQL(`SELECT * FROM "logins" WHERE user_id=$1`)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheLoginByUserIDFromThePrimary",
+			SetUp: func(t *testing.T) {
+				persistentLogin := persistententity.LoginFactory(nil)
+				login = persistentLogin.ToDomain()
+				updatedLogin = login
+
+				call = func(repository *logindatastorerepository.Repository) (domainentity.Login, error) {
+					return repository.GetByUserID(ctx, login.UserID.String())
+				}
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "username", "password", "version", "created_at", "updated_at"}).
+					AddRow(persistentLogin.ID, persistentLogin.UserID, persistentLogin.Username, persistentLogin.Password, persistentLogin.Version, persistentLogin.CreatedAt, persistentLogin.UpdatedAt)
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(selectByUserIDStmt)).
+					WithArgs(login.UserID).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInReadingTheUpdatedLoginBackFromThePrimary",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4()
+
+				args := map[string]interface{}{
+					"id": uuid.Nil,
+				}
+
+				persistentLogin := persistententity.LoginFactory(args)
+				login = persistentLogin.ToDomain()
+
+				call = func(repository *logindatastorerepository.Repository) (domainentity.Login, error) {
+					return repository.Update(ctx, id.String(), login)
+				}
+
+				primaryMock.ExpectBegin()
+
+				primaryMock.ExpectExec(regexp.QuoteMeta(updateStmt)).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				primaryMock.ExpectCommit()
+
+				updatedLogin = login
+				updatedLogin.ID = id
+				updatedLogin.Version = login.Version + 1
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "username", "password", "version", "created_at", "updated_at"}).
+					AddRow(id, persistentLogin.UserID, persistentLogin.Username, persistentLogin.Password, updatedLogin.Version, persistentLogin.CreatedAt, persistentLogin.UpdatedAt)
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(selectByIDStmt)).
+					WithArgs(id).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheVersionOfTheLoginDoesNotMatchTheOneStoredInThePrimary",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4()
+
+				args := map[string]interface{}{
+					"id": uuid.Nil,
+				}
+
+				persistentLogin := persistententity.LoginFactory(args)
+				login = persistentLogin.ToDomain()
+
+				call = func(repository *logindatastorerepository.Repository) (domainentity.Login, error) {
+					return repository.Update(ctx, id.String(), login)
+				}
+
+				primaryMock.ExpectBegin()
+
+				primaryMock.ExpectExec(regexp.QuoteMeta(updateStmt)).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				primaryMock.ExpectCommit()
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "username", "password", "version", "created_at", "updated_at"}).
+					AddRow(id, persistentLogin.UserID, persistentLogin.Username, persistentLogin.Password, login.Version+1, persistentLogin.CreatedAt, persistentLogin.UpdatedAt)
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(selectByIDStmt)).
+					WithArgs(id).
+					WillReturnRows(rows)
+
+				errorType = customerror.Conflict
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			db, primaryMock = NewMockDB(driver)
+
+			var replicaDB *sql.DB
+			replicaDB, replicaMock = NewMockReplica()
+
+			replicaSet, err := datastorepkg.NewReplicaSet([]*sql.DB{replicaDB}, datastorepkg.ReplicaConfig{})
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			err = db.Use(replicaSet)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			replicaMock.ExpectPing()
+			replicaSet.CheckHealth()
+
+			tc.SetUp(t)
+
+			persistentLoginRepository := logindatastorerepository.New(db).(*logindatastorerepository.Repository)
+
+			returnedLogin, err := call(persistentLoginRepository)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, updatedLogin.ID, returnedLogin.ID)
+				assert.Equal(t, updatedLogin.Version, returnedLogin.Version)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedLogin)
+			}
+
+			err = primaryMock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+
+			err = replicaMock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
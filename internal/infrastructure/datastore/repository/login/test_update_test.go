@@ -1,6 +1,7 @@
 package login_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -16,7 +17,9 @@ import (
 )
 
 func (ts *TestSuite) TestUpdate() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	var id uuid.UUID
@@ -27,9 +30,9 @@ func (ts *TestSuite) TestUpdate() {
 
 	errorType := customerror.NoType
 
-	firstStmt := `UPDATE "logins" SET "user_id"=$1,"username"=$2,"password"=$3,"updated_at"=$4 WHERE id=$5`
+	firstStmt := ts.SQL(`UPDATE "logins" SET "user_id"=$1,"username"=$2,"password"=$3,"version"=$4,"updated_at"=$5 WHERE id=$6 AND version=$7`)
 
-	secondStmt := `SELECT * FROM "logins" WHERE id=$1`
+	secondStmt := ts.SQL(`SELECT * FROM "logins" WHERE id=$1`)
 
 	ts.Cases = Cases{
 		{
@@ -54,14 +57,14 @@ func (ts *TestSuite) TestUpdate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
-					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				mock.ExpectCommit()
 
 				rows := sqlmock.
-					NewRows([]string{"id", "user_id", "username", "password", "created_at", "updated_at"}).
-					AddRow(id, updatedDatastoreLogin.UserID, updatedDatastoreLogin.Username, updatedDatastoreLogin.Password, updatedDatastoreLogin.CreatedAt, updatedDatastoreLogin.UpdatedAt)
+					NewRows([]string{"id", "user_id", "username", "password", "version", "created_at", "updated_at"}).
+					AddRow(id, updatedDatastoreLogin.UserID, updatedDatastoreLogin.Username, updatedDatastoreLogin.Password, updatedDatastoreLogin.Version, updatedDatastoreLogin.CreatedAt, updatedDatastoreLogin.UpdatedAt)
 
 				mock.ExpectQuery(regexp.QuoteMeta(secondStmt)).
 					WithArgs(id).
@@ -83,7 +86,7 @@ func (ts *TestSuite) TestUpdate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
-					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
 					WillReturnError(customerror.New("failed"))
 
 				mock.ExpectRollback()
@@ -106,15 +109,58 @@ func (ts *TestSuite) TestUpdate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
-					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 
 				mock.ExpectCommit()
 
+				mock.ExpectQuery(regexp.QuoteMeta(secondStmt)).
+					WithArgs(id).
+					WillReturnRows(&sqlmock.Rows{})
+
 				errorType = customerror.NotFound
 			},
 			WantError: true,
 		},
+		{
+			Context: "ItShouldFailIfTheLoginWasModifiedSinceItsVersion",
+			SetUp: func(t *testing.T) {
+				id = uuid.NewV4()
+
+				args := map[string]interface{}{
+					"id": uuid.Nil,
+				}
+
+				login = domainentity.LoginFactory(args)
+
+				args = map[string]interface{}{
+					"id":      id,
+					"userID":  login.UserID,
+					"version": login.Version + 1,
+				}
+
+				storedDatastoreLogin := persistententity.LoginFactory(args)
+
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectCommit()
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "username", "password", "version", "created_at", "updated_at"}).
+					AddRow(id, storedDatastoreLogin.UserID, storedDatastoreLogin.Username, storedDatastoreLogin.Password, storedDatastoreLogin.Version, storedDatastoreLogin.CreatedAt, storedDatastoreLogin.UpdatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(secondStmt)).
+					WithArgs(id).
+					WillReturnRows(rows)
+
+				errorType = customerror.Conflict
+			},
+			WantError: true,
+		},
 		{
 			Context: "ItShouldFailIfAnErrorOccursWhenFindingTheLoginByID",
 			SetUp: func(t *testing.T) {
@@ -129,7 +175,7 @@ func (ts *TestSuite) TestUpdate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
-					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				mock.ExpectCommit()
@@ -156,7 +202,7 @@ func (ts *TestSuite) TestUpdate() {
 				mock.ExpectBegin()
 
 				mock.ExpectExec(regexp.QuoteMeta(firstStmt)).
-					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+					WithArgs(login.UserID, login.Username, sqlmock.AnyArg(), login.Version+1, sqlmock.AnyArg(), id, login.Version).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				mock.ExpectCommit()
@@ -177,7 +223,7 @@ func (ts *TestSuite) TestUpdate() {
 
 			persistentLoginRepository := logindatastorerepository.New(db)
 
-			returnedLogin, err := persistentLoginRepository.Update(id.String(), login)
+			returnedLogin, err := persistentLoginRepository.Update(ctx, id.String(), login)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
@@ -185,6 +231,7 @@ func (ts *TestSuite) TestUpdate() {
 				assert.Equal(t, updatedLogin.UserID, returnedLogin.UserID)
 				assert.Equal(t, updatedLogin.Username, returnedLogin.Username)
 				assert.Equal(t, updatedLogin.Password, returnedLogin.Password)
+				assert.Equal(t, updatedLogin.Version, returnedLogin.Version)
 			} else {
 				assert.NotNil(t, err, "Predicted error lost.")
 				assert.Equal(t, errorType, customerror.GetType(err))
@@ -9,7 +9,7 @@ import (
 )
 
 func (ts *TestSuite) TestWithDBTrx() {
-	driver := "postgres"
+	driver := ts.Driver
 	db, _ := NewMockDB(driver)
 
 	dbTrx := &gorm.DB{}
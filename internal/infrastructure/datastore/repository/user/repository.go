@@ -1,12 +1,14 @@
 package user
 
 import (
-	"strings"
+	"context"
 
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/repository/user"
 	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	"gorm.io/gorm"
 )
 
@@ -25,27 +27,79 @@ func New(db *gorm.DB) userdatastorerepository.IRepository {
 }
 
 // Create is the function that creates a user in the database.
-func (r *Repository) Create(user domainentity.User) (domainentity.User, error) {
+func (r *Repository) Create(ctx context.Context, user domainentity.User) (domainentity.User, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserRepository.Create")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
 	persistentUser := persistententity.User{}
 	persistentUser.FromDomain(user)
 
-	if result := r.DB.Create(&persistentUser); result.Error != nil {
-		if strings.Contains(result.Error.Error(), "duplicate key value") {
-			return domainentity.User{}, customerror.Conflict.New(result.Error.Error())
+	if result := db.Create(&persistentUser); result.Error != nil {
+		if datastorepkg.IsUniqueViolation(result.Error, "users_username_key") {
+			return domainentity.User{}, customerror.Conflict.Newf("the user with username %s is already registered", user.Username)
 		}
 
-		return domainentity.User{}, result.Error
+		return domainentity.User{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return persistentUser.ToDomain(), nil
 }
 
 // GetAll is the function that gets the list of all users from the database.
-func (r *Repository) GetAll() (domainentity.Users, error) {
+func (r *Repository) GetAll(ctx context.Context) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserRepository.GetAll")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx)
+
+	usersDatastore := persistententity.Users{}
+
+	if result := db.Find(&usersDatastore); result.Error != nil {
+		return domainentity.Users{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
+	}
+
+	return usersDatastore.ToDomain(), nil
+}
+
+// GetByIDs is the function that gets the users of a set of ids from the database in a single query,
+// so that many users are not fetched one by one.
+func (r *Repository) GetByIDs(ctx context.Context, ids []string) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserRepository.GetByIDs")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return domainentity.Users{}, nil
+	}
+
+	db := r.DB.WithContext(ctx)
+
+	usersDatastore := persistententity.Users{}
+
+	if result := db.Order("id").Find(&usersDatastore, "id IN ?", ids); result.Error != nil {
+		return domainentity.Users{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
+	}
+
+	return usersDatastore.ToDomain(), nil
+}
+
+// GetPage is the function that gets a page of users ordered by id from the database, starting after the user
+// with the given id, or from the first user if it is empty.
+func (r *Repository) GetPage(ctx context.Context, afterID string, limit int) (domainentity.Users, error) {
+	ctx, span := tracingpkg.Start(ctx, "UserRepository.GetPage")
+	defer span.End()
+
+	db := r.DB.WithContext(ctx).Order("id").Limit(limit)
+
+	if afterID != "" {
+		db = db.Where("id > ?", afterID)
+	}
+
 	usersDatastore := persistententity.Users{}
 
-	if result := r.DB.Find(&usersDatastore); result.Error != nil {
-		return domainentity.Users{}, result.Error
+	if result := db.Find(&usersDatastore); result.Error != nil {
+		return domainentity.Users{}, datastorepkg.TranslateErrorContext(ctx, result.Error)
 	}
 
 	return usersDatastore.ToDomain(), nil
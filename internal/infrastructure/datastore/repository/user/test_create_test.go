@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -14,7 +15,9 @@ import (
 )
 
 func (ts *TestSuite) TestCreate() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	user := domainentity.User{}
@@ -23,7 +26,7 @@ func (ts *TestSuite) TestCreate() {
 
 	errorType := customerror.NoType
 
-	sqlQuery := `INSERT INTO "users" ("id","username","created_at","updated_at") VALUES ($1,$2,$3,$4)`
+	sqlQuery := ts.SQL(`INSERT INTO "users" ("id","username","created_at","updated_at") VALUES ($1,$2,$3,$4)`)
 
 	ts.Cases = Cases{
 		{
@@ -82,7 +85,7 @@ func (ts *TestSuite) TestCreate() {
 
 				mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
 					WithArgs(sqlmock.AnyArg(), user.Username, sqlmock.AnyArg(), sqlmock.AnyArg()).
-					WillReturnError(customerror.Conflict.New("duplicate key value"))
+					WillReturnError(ts.UniqueViolationError("users_username_key"))
 
 				mock.ExpectRollback()
 
@@ -98,7 +101,7 @@ func (ts *TestSuite) TestCreate() {
 
 			persistentUserRepository := userdatastorerepository.New(db)
 
-			returnedUser, err := persistentUserRepository.Create(user)
+			returnedUser, err := persistentUserRepository.Create(ctx, user)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
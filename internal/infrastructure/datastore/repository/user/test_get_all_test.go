@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -14,14 +15,16 @@ import (
 )
 
 func (ts *TestSuite) TestGetAll() {
-	driver := "postgres"
+	ctx := context.Background()
+
+	driver := ts.Driver
 	db, mock := NewMockDB(driver)
 
 	user := domainentity.User{}
 
 	errorType := customerror.NoType
 
-	sqlQuery := `SELECT * FROM "users"`
+	sqlQuery := ts.SQL(`SELECT * FROM "users"`)
 
 	ts.Cases = Cases{
 		{
@@ -49,6 +52,16 @@ func (ts *TestSuite) TestGetAll() {
 			},
 			WantError: true,
 		},
+		{
+			Context: "ItShouldFailIfTheConnectionToTheDatabaseIsLostWhenFindingAllUser",
+			SetUp: func(t *testing.T) {
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnError(ts.ConnectionFailureError())
+
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: true,
+		},
 	}
 
 	for _, tc := range ts.Cases {
@@ -57,7 +70,7 @@ func (ts *TestSuite) TestGetAll() {
 
 			persistentUserRepository := userdatastorerepository.New(db)
 
-			returnedUsers, err := persistentUserRepository.GetAll()
+			returnedUsers, err := persistentUserRepository.GetAll(ctx)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
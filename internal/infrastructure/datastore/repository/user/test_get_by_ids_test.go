@@ -0,0 +1,94 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetByIDs() {
+	ctx := context.Background()
+
+	driver := ts.Driver
+	db, mock := NewMockDB(driver)
+
+	ids := make([]string, 0)
+
+	users := domainentity.Users{}
+
+	errorType := customerror.NoType
+
+	sqlQuery := ts.SQL(`SELECT * FROM "users" WHERE id IN ($1,$2) ORDER BY id`)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheUsersOfASetOfIDsInASingleQuery",
+			SetUp: func(t *testing.T) {
+				persistentUser := persistententity.UserFactory(nil)
+				ids = []string{persistentUser.ID.String(), uuid.NewV4().String()}
+				users = domainentity.Users{persistentUser.ToDomain()}
+
+				rows := sqlmock.
+					NewRows([]string{"id", "username", "created_at", "updated_at"}).
+					AddRow(persistentUser.ID, persistentUser.Username, persistentUser.CreatedAt, persistentUser.UpdatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WithArgs(ids[0], ids[1]).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingNoUsersWithoutQueryingIfNoIDIsInformed",
+			SetUp: func(t *testing.T) {
+				ids = []string{}
+				users = domainentity.Users{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfAnErrorOccursWhenFindingTheUsersOfASetOfIDs",
+			SetUp: func(t *testing.T) {
+				ids = []string{uuid.NewV4().String(), uuid.NewV4().String()}
+
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WithArgs(ids[0], ids[1]).
+					WillReturnError(customerror.New("failed"))
+
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentUserRepository := userdatastorerepository.New(db)
+
+			returnedUsers, err := persistentUserRepository.GetByIDs(ctx, ids)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, users, returnedUsers)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedUsers)
+			}
+
+			err = mock.ExpectationsWereMet()
+			assert.Nil(ts.T(), err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
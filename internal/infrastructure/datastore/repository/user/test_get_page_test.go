@@ -0,0 +1,107 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/user"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetPage() {
+	ctx := context.Background()
+
+	driver := ts.Driver
+	db, mock := NewMockDB(driver)
+
+	afterID := ""
+
+	limit := 2
+
+	users := domainentity.Users{}
+
+	errorType := customerror.NoType
+
+	sqlQuery := ts.SQL(`SELECT * FROM "users" ORDER BY id LIMIT 2`)
+
+	sqlQueryAfter := ts.SQL(`SELECT * FROM "users" WHERE id > $1 ORDER BY id LIMIT 2`)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheFirstPageOfUsers",
+			SetUp: func(t *testing.T) {
+				afterID = ""
+
+				persistentUser := persistententity.UserFactory(nil)
+				users = domainentity.Users{persistentUser.ToDomain()}
+
+				rows := sqlmock.
+					NewRows([]string{"id", "username", "created_at", "updated_at"}).
+					AddRow(persistentUser.ID, persistentUser.Username, persistentUser.CreatedAt, persistentUser.UpdatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingThePageOfUsersAfterAUser",
+			SetUp: func(t *testing.T) {
+				afterID = uuid.NewV4().String()
+
+				persistentUser := persistententity.UserFactory(nil)
+				users = domainentity.Users{persistentUser.ToDomain()}
+
+				rows := sqlmock.
+					NewRows([]string{"id", "username", "created_at", "updated_at"}).
+					AddRow(persistentUser.ID, persistentUser.Username, persistentUser.CreatedAt, persistentUser.UpdatedAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQueryAfter)).
+					WithArgs(afterID).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheConnectionToTheDatabaseIsLostWhenFindingAPageOfUsers",
+			SetUp: func(t *testing.T) {
+				afterID = ""
+
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnError(ts.ConnectionFailureError())
+
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			persistentUserRepository := userdatastorerepository.New(db)
+
+			returnedUsers, err := persistentUserRepository.GetPage(ctx, afterID, limit)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
+				assert.Equal(t, users, returnedUsers)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+				assert.Empty(t, returnedUsers)
+			}
+
+			err = mock.ExpectationsWereMet()
+			assert.Nil(ts.T(), err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
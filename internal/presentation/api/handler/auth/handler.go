@@ -1,11 +1,13 @@
 package auth
 
 import (
-	"encoding/json"
 	"net/http"
 
 	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/auth"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	etaghttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/etag"
+	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
 	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
 	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
@@ -36,26 +38,28 @@ func New(authService authservice.IService) IHandler {
 // @failure 400 {object} error.Error
 // @failure 404 {object} error.Error
 // @failure 409 {object} error.Error
+// @failure 413 {object} error.Error
+// @failure 429 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /sign_up [POST]
+// @router /v1/sign_up [POST]
 func (h *Handler) SignUp(w http.ResponseWriter, r *http.Request) {
 	dbTrx, ok := dbtrxmiddlewarepkg.FromContext(r.Context())
 	if !ok || dbTrx == nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed to get the db_trx value from the request context"))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the db_trx value from the request context"))
 		return
 	}
 
 	credentials := securitypkg.Credentials{}
 
-	err := json.NewDecoder(r.Body).Decode(&credentials)
+	err := requesthttputilpkg.DecodeJSON(r, &credentials)
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.BadRequest.New(err.Error()))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
-	token, err := h.AuthService.WithDBTrx(dbTrx).Register(credentials)
+	token, err := h.AuthService.WithDBTrx(dbTrx).Register(r.Context(), credentials)
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
@@ -75,25 +79,27 @@ func (h *Handler) SignUp(w http.ResponseWriter, r *http.Request) {
 // @failure 401 {object} error.Error
 // @failure 404 {object} error.Error
 // @failure 409 {object} error.Error
+// @failure 413 {object} error.Error
+// @failure 429 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /sign_in [POST]
+// @router /v1/sign_in [POST]
 func (h *Handler) SignIn(w http.ResponseWriter, r *http.Request) {
 	dbTrx, ok := dbtrxmiddlewarepkg.FromContext(r.Context())
 	if !ok || dbTrx == nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed to get the db_trx value from the request context"))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the db_trx value from the request context"))
 		return
 	}
 
 	credentials := securitypkg.Credentials{}
 
-	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.BadRequest.New(err.Error()))
+	if err := requesthttputilpkg.DecodeJSON(r, &credentials); err != nil {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
-	token, err := h.AuthService.WithDBTrx(dbTrx).LogIn(credentials)
+	token, err := h.AuthService.WithDBTrx(dbTrx).LogIn(r.Context(), credentials)
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
@@ -110,23 +116,58 @@ func (h *Handler) SignIn(w http.ResponseWriter, r *http.Request) {
 // @failure 400 {object} error.Error
 // @failure 401 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /refresh_token [POST]
+// @router /v1/refresh_token [POST]
 // @security ApiKeyAuth
 func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	auth, ok := authmiddlewarepkg.FromContext(r.Context())
 	if !ok || auth.IsEmpty() {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed to get the auth_details value from the request context"))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the auth_details value from the request context"))
 		return
 	}
 
-	token, err := h.AuthService.WithDBTrx(nil).RenewToken(auth)
+	token, err := h.AuthService.WithDBTrx(nil).RenewToken(r.Context(), auth)
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 	}
 
 	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, tokenhttputilpkg.Token{Text: token})
 }
 
+// GetLogin godoc
+// @tags authentication
+// @summary API endpoint to get the login of the authenticated user.
+// @description
+// @id GetLogin
+// @produce json
+// @success 200 {object} presentity.Login
+// @header 200 {string} ETag "Entity tag of the login, expected by the If-Match header of the password changes"
+// @failure 400 {object} error.Error
+// @failure 401 {object} error.Error
+// @failure 404 {object} error.Error
+// @failure 500 {object} error.Error
+// @router /v1/login [GET]
+// @security ApiKeyAuth
+func (h *Handler) GetLogin(w http.ResponseWriter, r *http.Request) {
+	auth, ok := authmiddlewarepkg.FromContext(r.Context())
+	if !ok || auth.IsEmpty() {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the auth_details value from the request context"))
+		return
+	}
+
+	domainLogin, err := h.AuthService.WithDBTrx(nil).GetLogin(r.Context(), auth.UserID.String())
+	if err != nil {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+		return
+	}
+
+	login := presentableentity.Login{}
+	login.FromDomain(domainLogin)
+
+	etaghttputilpkg.SetHeader(w, domainLogin.Version)
+
+	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, login)
+}
+
 // ChangePassword godoc
 // @tags authentication
 // @summary API endpoint to reset the user's password.
@@ -135,33 +176,47 @@ func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 // @accept json
 // @produce json
 // @param passwords body securitypkg.Passwords true "Reset Password"
+// @param If-Match header string false "Entity tags of the login, as returned by GET /v1/login or a previous password change"
 // @success 200 {object} message.Message
+// @header 200 {string} ETag "Entity tag of the updated login"
 // @failure 400 {object} error.Error
 // @failure 401 {object} error.Error
 // @failure 404 {object} error.Error
+// @failure 409 {object} error.Error
+// @failure 412 {object} error.Error
+// @failure 413 {object} error.Error
+// @failure 429 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /change_password [POST]
+// @router /v1/change_password [POST]
 // @security ApiKeyAuth
 func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	auth, ok := authmiddlewarepkg.FromContext(r.Context())
 	if !ok || auth.IsEmpty() {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed to get the auth_details value from the request context"))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the auth_details value from the request context"))
 		return
 	}
 
 	passwords := securitypkg.Passwords{}
 
-	if err := json.NewDecoder(r.Body).Decode(&passwords); err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.BadRequest.New(err.Error()))
+	if err := requesthttputilpkg.DecodeJSON(r, &passwords); err != nil {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+		return
+	}
+
+	ifMatch, err := etaghttputilpkg.ParseIfMatch(r)
+	if err != nil {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
-	err := h.AuthService.WithDBTrx(nil).ModifyPassword(auth.UserID.String(), passwords)
+	version, err := h.AuthService.WithDBTrx(nil).ModifyPassword(r.Context(), auth.UserID.String(), passwords, ifMatch)
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
+	etaghttputilpkg.SetHeader(w, version)
+
 	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, responsehttputilpkg.Message{Text: "the password has been updated successfully"})
 }
 
@@ -176,18 +231,18 @@ func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 // @failure 401 {object} error.Error
 // @failure 404 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /sign_out [POST]
+// @router /v1/sign_out [POST]
 // @security ApiKeyAuth
 func (h *Handler) SignOut(w http.ResponseWriter, r *http.Request) {
 	auth, ok := authmiddlewarepkg.FromContext(r.Context())
 	if !ok || auth.IsEmpty() {
-		responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed to get the auth_details value from the request context"))
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed to get the auth_details value from the request context"))
 		return
 	}
 
-	err := h.AuthService.WithDBTrx(nil).LogOut(auth.ID.String())
+	err := h.AuthService.WithDBTrx(nil).LogOut(r.Context(), auth.ID.String())
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
@@ -0,0 +1,161 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v5"
+	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/application/service/auth"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/auth"
+	logindatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/login"
+	userdatastoremockrepository "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/infrastructure/datastore/mockrepository/user"
+	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	mockauth "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockauth"
+	mocksecuritypkg "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mocksecurity"
+	mockvalidator "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockvalidator"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestSignIn() {
+	credentials := securitypkg.Credentials{}
+
+	login := domainentity.Login{}
+
+	auth := domainentity.Auth{}
+
+	locale := ""
+
+	statusCode := 0
+
+	code := ""
+
+	detail := ""
+
+	catalog, err := i18npkg.New()
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldFailIfTheUserIsAlreadyLoggedIn",
+			SetUp: func(t *testing.T) {
+				credentials = securitypkg.CredentialsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: credentials.Username,
+					Password: credentials.Password,
+				}
+
+				auth = domainentity.Auth{
+					ID:     uuid.NewV4(),
+					UserID: login.UserID,
+				}
+
+				locale = i18npkg.English
+
+				statusCode = http.StatusConflict
+				code = authservice.CodeAlreadyLoggedIn
+				detail = fmt.Sprintf("the user with username %s is already logged in", credentials.Username)
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheUserIsAlreadyLoggedInWithTheMessageTranslatedIntoTheLocale",
+			SetUp: func(t *testing.T) {
+				credentials = securitypkg.CredentialsFactory(nil)
+
+				login = domainentity.Login{
+					ID:       uuid.NewV4(),
+					UserID:   uuid.NewV4(),
+					Username: credentials.Username,
+					Password: credentials.Password,
+				}
+
+				auth = domainentity.Auth{
+					ID:     uuid.NewV4(),
+					UserID: login.UserID,
+				}
+
+				locale = i18npkg.BrazilianPortuguese
+
+				statusCode = http.StatusConflict
+				code = authservice.CodeAlreadyLoggedIn
+				detail = fmt.Sprintf("o usuário %s já está conectado", credentials.Username)
+			},
+			WantError: true,
+		},
+	}
+
+	responsehttputilpkg.SetCatalog(catalog)
+	defer responsehttputilpkg.SetCatalog(nil)
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			validator := new(mockvalidator.Validator)
+			validator.On("Validate", credentials).Return(nil)
+
+			persistentLoginRepository := new(logindatastoremockrepository.Repository)
+			persistentLoginRepository.On("WithDBTrx", mock.Anything).Return(persistentLoginRepository)
+			persistentLoginRepository.On("GetByUsername", mock.Anything, credentials.Username).Return(login, nil)
+
+			security := new(mocksecuritypkg.Security)
+			security.On("VerifyPasswords", login.Password, credentials.Password).Return(nil)
+
+			persistentAuthRepository := new(authdatastoremockrepository.Repository)
+			persistentAuthRepository.On("WithDBTrx", mock.Anything).Return(persistentAuthRepository)
+			persistentAuthRepository.On("GetByUserID", mock.Anything, login.UserID.String()).Return(auth, nil)
+
+			persistentUserRepository := new(userdatastoremockrepository.Repository)
+			persistentUserRepository.On("WithDBTrx", mock.Anything).Return(persistentUserRepository)
+
+			authN := new(mockauth.Auth)
+
+			authService := authservice.New(persistentAuthRepository, persistentLoginRepository, persistentUserRepository,
+				authN, security, validator, fake.Number(2, 10))
+
+			authHandler := authhandler.New(authService)
+
+			body, err := json.Marshal(credentials)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/sign_in", strings.NewReader(string(body)))
+			req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+
+			ctx := dbtrxmiddlewarepkg.NewContext(req.Context(), &gorm.DB{})
+			ctx = i18npkg.NewContext(ctx, locale)
+			req = req.WithContext(ctx)
+
+			resprec := httptest.NewRecorder()
+
+			authHandler.SignIn(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Code)
+
+			if tc.WantError {
+				problem := responsehttputilpkg.Problem{}
+				err = json.NewDecoder(resprec.Body).Decode(&problem)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, code, problem.Code)
+				assert.Equal(t, detail, problem.Detail)
+				assert.Equal(t, locale, resprec.Result().Header.Get("Content-Language"))
+			}
+
+			persistentAuthRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
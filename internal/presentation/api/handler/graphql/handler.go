@@ -0,0 +1,123 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/auth"
+	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/user"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	gqlerrorgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/gqlerror"
+	limitgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/limit"
+	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+)
+
+// Config is the configuration of the GraphQL endpoint.
+type Config struct {
+	// MaxDepth is the max number of nested levels of fields of a query. The depth is not limited if it is zero.
+	MaxDepth int
+	// MaxComplexity is the max number of fields a query resolves. The complexity is not limited if it is zero.
+	MaxComplexity int
+	// DefaultPageSize is the number of the items of a connection whose first argument is not informed.
+	DefaultPageSize int
+	// MaxPageSize is the max number of the items of a connection.
+	MaxPageSize int
+}
+
+type Handler struct {
+	UserService userservice.IService
+	AuthService authservice.IService
+	Schema      graphql.Schema
+	Config      Config
+}
+
+// New is the factory function that encapsulates the implementation related to GraphQL handler.
+func New(userService userservice.IService, authService authservice.IService, config Config) (IHandler, error) {
+	handler := &Handler{
+		UserService: userService,
+		AuthService: authService,
+		Config:      config,
+	}
+
+	schema, err := newSchema(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.Schema = schema
+
+	return handler, nil
+}
+
+// Query godoc
+// @tags graphql
+// @summary API endpoint to query the users and their sessions with GraphQL.
+// @description
+// @id GraphQL
+// @accept json
+// @produce json
+// @param query body presentity.GraphQLRequest true "GraphQL query"
+// @success 200 {object} presentity.GraphQLResponse
+// @failure 400 {object} error.Error
+// @failure 401 {object} error.Error
+// @router /graphql [POST]
+// @security ApiKeyAuth
+func (h *Handler) Query(w http.ResponseWriter, r *http.Request) {
+	request := presentableentity.GraphQLRequest{}
+
+	if err := requesthttputilpkg.DecodeJSON(r, &request); err != nil {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+		return
+	}
+
+	if request.Query == "" {
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.BadRequest.New("the query is required"))
+		return
+	}
+
+	ctx := newContextWithLoaders(r.Context(), h)
+
+	response := presentableentity.GraphQLResponse{}
+	response.FromResult(h.execute(ctx, request))
+
+	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, response)
+}
+
+// execute is the function that parses, validates and executes a query. The queries beyond the limits are not executed,
+// so that a costly query does not reach the database.
+func (h *Handler) execute(ctx context.Context, request presentableentity.GraphQLRequest) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if validationResult := graphql.ValidateDocument(&h.Schema, document, nil); !validationResult.IsValid {
+		return &graphql.Result{Errors: validationResult.Errors}
+	}
+
+	limits := limitgraphqlutilpkg.Limits{
+		MaxDepth:        h.Config.MaxDepth,
+		MaxComplexity:   h.Config.MaxComplexity,
+		DefaultListSize: h.Config.DefaultPageSize,
+	}
+
+	if err = limitgraphqlutilpkg.Check(&h.Schema, document, request.OperationName, request.Variables, limits); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(gqlerrorgraphqlutilpkg.New(ctx, err))}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.Schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+}
@@ -0,0 +1,8 @@
+package graphql
+
+import "net/http"
+
+// IHandler interface is a collection of function signatures that represents the GraphQL's handler contract.
+type IHandler interface {
+	Query(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"context"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	dataloaderpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/dataloader"
+)
+
+// loaders are the loaders of a request, which batch the users and the sessions requested by the resolvers of the same level,
+// so that the nested fields of a list do not query the database once per item.
+type loaders struct {
+	users    *dataloaderpkg.Loader[string, domainentity.User]
+	sessions *dataloaderpkg.Loader[string, domainentity.Auths]
+}
+
+type loadersContextKey struct{}
+
+// newContextWithLoaders is the function that returns a copy of the context that stores new loaders,
+// which fetch the users and the sessions through the services of the handler.
+func newContextWithLoaders(ctx context.Context, h *Handler) context.Context {
+	return context.WithValue(ctx, loadersContextKey{}, &loaders{
+		users:    dataloaderpkg.New(h.loadUsers),
+		sessions: dataloaderpkg.New(h.loadSessions),
+	})
+}
+
+// loadersFromContext is the function that gets the loaders stored in the context.
+func loadersFromContext(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersContextKey{}).(*loaders)
+	return l
+}
+
+// loadUsers is the function that fetches the users of a batch of ids at once.
+func (h *Handler) loadUsers(ctx context.Context, ids []string) (map[string]domainentity.User, error) {
+	users, err := h.UserService.WithDBTrx(nil).GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[string]domainentity.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID.String()] = user
+	}
+
+	return usersByID, nil
+}
+
+// loadSessions is the function that fetches the sessions of a batch of users at once, grouped by the ids of the users.
+func (h *Handler) loadSessions(ctx context.Context, userIDs []string) (map[string]domainentity.Auths, error) {
+	auths, err := h.AuthService.WithDBTrx(nil).GetByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	authsByUserID := make(map[string]domainentity.Auths, len(userIDs))
+	for _, auth := range auths {
+		authsByUserID[auth.UserID.String()] = append(authsByUserID[auth.UserID.String()], auth)
+	}
+
+	return authsByUserID, nil
+}
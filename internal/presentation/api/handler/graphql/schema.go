@@ -0,0 +1,187 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	gqlerrorgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/gqlerror"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	uuid "github.com/satori/go.uuid"
+)
+
+// newSchema is the function that builds the schema of the GraphQL endpoint:
+//
+//	type Query {
+//	  viewer: User!
+//	  users(first: Int, after: String): UserConnection!
+//	}
+//
+//	type User {
+//	  id: ID!
+//	  username: String!
+//	  sessions(first: Int, after: String): SessionConnection
+//	}
+//
+//	type Session {
+//	  id: ID!
+//	  user: User!
+//	}
+//
+// The lists are cursor-based connections ordered by id, and the users and the sessions nested in them are batched by loaders.
+// The sessions of a user are only resolved for the authenticated user, and are null along with an error for the others.
+func newSchema(h *Handler) (graphql.Schema, error) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "User",
+		Description: "A user registered to the system.",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domainentity.User).ID.String(), nil
+				},
+			},
+			"username": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+		},
+	})
+
+	sessionType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Session",
+		Description: "A session of a logged in user.",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domainentity.Auth).ID.String(), nil
+				},
+			},
+			"user": &graphql.Field{
+				Type: graphql.NewNonNull(userType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return h.loadUser(p, p.Source.(domainentity.Auth).UserID.String()), nil
+				},
+			},
+		},
+	})
+
+	// The sessions field is added after the session type is built, since the two types refer to each other.
+	// It is nullable, so that the denial of the sessions of another user does not void the rest of the data.
+	userType.AddFieldConfig("sessions", &graphql.Field{
+		Type:        connectiongraphqlutilpkg.NewType(sessionType),
+		Description: "The sessions of the user, which are only accessible to the user.",
+		Args:        connectiongraphqlutilpkg.Arguments(),
+		Resolve:     h.resolveSessions,
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"viewer": &graphql.Field{
+				Type:        graphql.NewNonNull(userType),
+				Description: "The authenticated user.",
+				Resolve:     h.resolveViewer,
+			},
+			"users": &graphql.Field{
+				Type:        graphql.NewNonNull(connectiongraphqlutilpkg.NewType(userType)),
+				Description: "The users registered to the system.",
+				Args:        connectiongraphqlutilpkg.Arguments(),
+				Resolve:     h.resolveUsers,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// resolveViewer is the function that resolves the user the token of the request was issued to.
+func (h *Handler) resolveViewer(p graphql.ResolveParams) (interface{}, error) {
+	auth, ok := authmiddlewarepkg.FromContext(p.Context)
+	if !ok || auth.IsEmpty() {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, customerror.Unauthorized.New("the request is not authenticated"))
+	}
+
+	return h.loadUser(p, auth.UserID.String()), nil
+}
+
+// resolveUsers is the function that resolves a page of the users, which is fetched along with the first user
+// of the next page so as to know whether there is one.
+func (h *Handler) resolveUsers(p graphql.ResolveParams) (interface{}, error) {
+	args, err := connectiongraphqlutilpkg.ParseArgs(p.Args, h.Config.DefaultPageSize, h.Config.MaxPageSize, isUUIDKey)
+	if err != nil {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, err)
+	}
+
+	users, err := h.UserService.WithDBTrx(nil).GetPage(p.Context, args.After, args.First+1)
+	if err != nil {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, err)
+	}
+
+	return connectiongraphqlutilpkg.New(users, args.First, userKey), nil
+}
+
+// resolveSessions is the function that resolves a page of the sessions of a user, which is denied
+// unless the user is the authenticated one, since the ids of the sessions identify the tokens of the user.
+// The sessions requested at the same level are fetched at once by the sessions loader.
+func (h *Handler) resolveSessions(p graphql.ResolveParams) (interface{}, error) {
+	auth, ok := authmiddlewarepkg.FromContext(p.Context)
+	if !ok || auth.IsEmpty() {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, customerror.Unauthorized.New("the request is not authenticated"))
+	}
+
+	user := p.Source.(domainentity.User)
+	if user.ID != auth.UserID {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, customerror.Forbidden.Newf("the sessions of the user with id %s are not accessible", user.ID.String()))
+	}
+
+	args, err := connectiongraphqlutilpkg.ParseArgs(p.Args, h.Config.DefaultPageSize, h.Config.MaxPageSize, isUUIDKey)
+	if err != nil {
+		return nil, gqlerrorgraphqlutilpkg.New(p.Context, err)
+	}
+
+	thunk := loadersFromContext(p.Context).sessions.Load(p.Context, user.ID.String())
+
+	return func() (interface{}, error) {
+		auths, err := thunk()
+		if err != nil {
+			return nil, gqlerrorgraphqlutilpkg.New(p.Context, err)
+		}
+
+		return connectiongraphqlutilpkg.Paginate(auths, args, sessionKey), nil
+	}, nil
+}
+
+// loadUser is the function that returns the thunk that resolves a user by id through the users loader.
+func (h *Handler) loadUser(p graphql.ResolveParams, id string) func() (interface{}, error) {
+	thunk := loadersFromContext(p.Context).users.Load(p.Context, id)
+
+	return func() (interface{}, error) {
+		user, err := thunk()
+		if err != nil {
+			return nil, gqlerrorgraphqlutilpkg.New(p.Context, err)
+		}
+
+		if user.ID == uuid.Nil {
+			return nil, gqlerrorgraphqlutilpkg.New(p.Context, customerror.NotFound.Newf("the user with id %s was not found", id))
+		}
+
+		return user, nil
+	}
+}
+
+// userKey is the function that gets the key of a user in the connections, by which they are ordered.
+func userKey(user domainentity.User) string {
+	return user.ID.String()
+}
+
+// isUUIDKey is the function that checks whether the key of a cursor is a UUID, as the ones of the users and the sessions are.
+func isUUIDKey(key string) bool {
+	_, err := uuid.FromString(key)
+	return err == nil
+}
+
+// sessionKey is the function that gets the key of a session in the connections, by which they are ordered.
+func sessionKey(auth domainentity.Auth) string {
+	return auth.ID.String()
+}
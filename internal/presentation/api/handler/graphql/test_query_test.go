@@ -0,0 +1,323 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	graphqlhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/graphql"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestQuery() {
+	config := graphqlhandler.Config{
+		MaxDepth:        7,
+		MaxComplexity:   200,
+		DefaultPageSize: 2,
+		MaxPageSize:     10,
+	}
+
+	users := domainentity.Users{
+		{ID: uuid.FromStringOrNil("00000000-0000-0000-0000-000000000001"), Username: "alice"},
+		{ID: uuid.FromStringOrNil("00000000-0000-0000-0000-000000000002"), Username: "bob"},
+		{ID: uuid.FromStringOrNil("00000000-0000-0000-0000-000000000003"), Username: "carol"},
+	}
+
+	auths := domainentity.Auths{
+		{ID: uuid.FromStringOrNil("00000000-0000-0000-0000-00000000000a"), UserID: users[0].ID},
+		{ID: uuid.FromStringOrNil("00000000-0000-0000-0000-00000000000b"), UserID: users[1].ID},
+	}
+
+	var userService *usermockservice.Service
+
+	var authService *authmockservice.Service
+
+	ctx := context.Background()
+
+	body := ""
+
+	statusCode := 0
+
+	data := ""
+
+	errorCode := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInQueryingAPageOfUsers",
+			SetUp: func(t *testing.T) {
+				ctx = authmiddlewarepkg.NewContext(context.Background(), auths[0])
+				body = `{"query": "{ users { edges { cursor node { username } } pageInfo { hasNextPage endCursor } } }"}`
+
+				userService.On("GetPage", mock.Anything, "", 3).Return(users, nil).Once()
+
+				cursor0 := connectiongraphqlutilpkg.EncodeCursor(users[0].ID.String())
+				cursor1 := connectiongraphqlutilpkg.EncodeCursor(users[1].ID.String())
+
+				statusCode = http.StatusOK
+				data = fmt.Sprintf(`{"users": {"edges": [`+
+					`{"cursor": %q, "node": {"username": "alice"}},`+
+					`{"cursor": %q, "node": {"username": "bob"}}],`+
+					`"pageInfo": {"hasNextPage": true, "endCursor": %q}}}`,
+					cursor0, cursor1, cursor1)
+				errorCode = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInQueryingTheSessionsOfTheAuthenticatedUserWithTheirUsersFetchedInASingleBatch",
+			SetUp: func(t *testing.T) {
+				ctx = authmiddlewarepkg.NewContext(context.Background(), auths[0])
+				body = `{"query": "{ viewer { username sessions { edges { node { id user { username } } } } } }"}`
+
+				userService.On("GetByIDs", mock.Anything, []string{users[0].ID.String()}).Return(users[:1], nil).Once()
+				authService.On("GetByUserIDs", mock.Anything, []string{users[0].ID.String()}).Return(auths[:1], nil).Once()
+
+				statusCode = http.StatusOK
+				data = fmt.Sprintf(`{"viewer": {"username": "alice", "sessions": {"edges": [{"node": {"id": %q, "user": {"username": "alice"}}}]}}}`,
+					auths[0].ID.String())
+				errorCode = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInQueryingThePageOfUsersAfterACursor",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = fmt.Sprintf(`{"query": "query Users($after: String) { users(first: 5, after: $after) { edges { node { username } } pageInfo { hasNextPage } } }", "variables": {"after": %q}}`,
+					connectiongraphqlutilpkg.EncodeCursor(users[1].ID.String()))
+
+				userService.On("GetPage", mock.Anything, users[1].ID.String(), 6).Return(users[2:], nil).Once()
+
+				statusCode = http.StatusOK
+				data = `{"users": {"edges": [{"node": {"username": "carol"}}], "pageInfo": {"hasNextPage": false}}}`
+				errorCode = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInQueryingTheAuthenticatedUser",
+			SetUp: func(t *testing.T) {
+				ctx = authmiddlewarepkg.NewContext(context.Background(), auths[0])
+				body = `{"query": "{ viewer { id username } }"}`
+
+				userService.On("GetByIDs", mock.Anything, []string{users[0].ID.String()}).Return(users[:1], nil).Once()
+
+				statusCode = http.StatusOK
+				data = fmt.Sprintf(`{"viewer": {"id": %q, "username": "alice"}}`, users[0].ID.String())
+				errorCode = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheRequestIsNotAuthenticatedWhenQueryingTheAuthenticatedUser",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ viewer { id } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = "UNAUTHORIZED"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheSessionsOfAnotherUserAreQueried",
+			SetUp: func(t *testing.T) {
+				ctx = authmiddlewarepkg.NewContext(context.Background(), auths[0])
+				body = `{"query": "{ users { edges { node { username sessions { edges { node { id } } } } } } }"}`
+
+				userService.On("GetPage", mock.Anything, "", 3).Return(users, nil).Once()
+				authService.On("GetByUserIDs", mock.Anything, []string{users[0].ID.String()}).Return(auths[:1], nil).Once()
+
+				statusCode = http.StatusOK
+				data = fmt.Sprintf(`{"users": {"edges": [`+
+					`{"node": {"username": "alice", "sessions": {"edges": [{"node": {"id": %q}}]}}},`+
+					`{"node": {"username": "bob", "sessions": null}}]}}`,
+					auths[0].ID.String())
+				errorCode = "FORBIDDEN"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheRequestIsNotAuthenticatedWhenQueryingTheSessionsOfAUser",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users(first: 1) { edges { node { sessions { edges { node { id } } } } } } }"}`
+
+				userService.On("GetPage", mock.Anything, "", 2).Return(users[:2], nil).Once()
+
+				statusCode = http.StatusOK
+				data = `{"users": {"edges": [{"node": {"sessions": null}}]}}`
+				errorCode = "UNAUTHORIZED"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheCursorIsNotValid",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users(after: \"not-a-cursor\") { pageInfo { hasNextPage } } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = connectiongraphqlutilpkg.CodeInvalidCursor
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheKeyOfTheCursorIsNotAUUID",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = fmt.Sprintf(`{"query": "query Users($after: String) { users(after: $after) { pageInfo { hasNextPage } } }", "variables": {"after": %q}}`,
+					connectiongraphqlutilpkg.EncodeCursor("foo"))
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = connectiongraphqlutilpkg.CodeInvalidCursor
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfThePageSizeExceedsTheLimit",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users(first: 11) { pageInfo { hasNextPage } } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = connectiongraphqlutilpkg.CodeInvalidPageSize
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsTooDeep",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users { edges { node { sessions { edges { node { user { username } } } } } } } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = "QUERY_TOO_DEEP"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsTooComplex",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users(first: 10) { edges { node { sessions(first: 10) { edges { node { id } } } } } } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = "QUERY_TOO_COMPLEX"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheUsersCannotBeFetched",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users { pageInfo { hasNextPage } } }"}`
+
+				userService.On("GetPage", mock.Anything, "", 3).Return(domainentity.Users{}, customerror.New("failed")).Once()
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = "INTERNAL_ERROR"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsNotValid",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"query": "{ users { unknown } }"}`
+
+				statusCode = http.StatusOK
+				data = ""
+				errorCode = ""
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsNotInformed",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				body = `{"variables": {}}`
+
+				statusCode = http.StatusBadRequest
+				data = ""
+				errorCode = ""
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			userService = new(usermockservice.Service)
+			userService.On("WithDBTrx", mock.Anything).Return(userService).Maybe()
+
+			authService = new(authmockservice.Service)
+			authService.On("WithDBTrx", mock.Anything).Return(authService).Maybe()
+
+			tc.SetUp(t)
+
+			graphQLHandler, err := graphqlhandler.New(userService, authService, config)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)).WithContext(ctx)
+			resprec := httptest.NewRecorder()
+
+			graphQLHandler.Query(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Code)
+
+			userService.AssertExpectations(t)
+			authService.AssertExpectations(t)
+
+			if statusCode != http.StatusOK {
+				return
+			}
+
+			response := struct {
+				Data   json.RawMessage `json:"data"`
+				Errors []struct {
+					Message    string                 `json:"message"`
+					Extensions map[string]interface{} `json:"extensions"`
+				} `json:"errors"`
+			}{}
+
+			err = json.Unmarshal(resprec.Body.Bytes(), &response)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			if !tc.WantError {
+				assert.Empty(t, response.Errors)
+				assert.JSONEq(t, data, string(response.Data))
+			} else {
+				assert.NotEmpty(t, response.Errors, "Predicted error lost.")
+
+				if data != "" {
+					assert.JSONEq(t, data, string(response.Data))
+				}
+
+				if errorCode != "" && len(response.Errors) > 0 {
+					assert.Equal(t, errorCode, response.Errors[0].Extensions["code"])
+				}
+			}
+		})
+	}
+}
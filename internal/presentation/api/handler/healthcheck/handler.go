@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/healthcheck"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
 	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 )
 
@@ -26,12 +28,78 @@ func New(healthCheckService healthcheckservice.IService) IHandler {
 // @produce json
 // @success 200 {object} message.Message
 // @failure 500 {object} error.Error
+// @failure 503 {object} error.Error
 // @router /status [GET]
 func (h *Handler) GetStatus(w http.ResponseWriter, r *http.Request) {
 	if err := h.HealthCheckService.GetStatus(); err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+		return
 	}
 
 	text := "everything is up and running"
 	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, responsehttputilpkg.Message{Text: text})
 }
+
+// GetLiveness godoc
+// @tags health check
+// @summary API endpoint used to verify if the process is alive, regardless of its dependencies.
+// @description
+// @id GetLiveness
+// @produce json
+// @success 200 {object} presentity.Health
+// @router /livez [GET]
+func (h *Handler) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	responsehttputilpkg.RespondWithJSON(w, http.StatusOK, presentableentity.Health{Status: healthpkg.StatusPass})
+}
+
+// GetReadiness godoc
+// @tags health check
+// @summary API endpoint used to verify if the service is ready to accept requests, based on the health of its dependencies.
+// @description
+// @id GetReadiness
+// @produce json
+// @success 200 {object} presentity.Health
+// @failure 503 {object} presentity.Health
+// @router /readyz [GET]
+func (h *Handler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	h.respondWithHealth(w, r, false)
+}
+
+// GetHealthDetails godoc
+// @tags health check
+// @summary API endpoint used to get the status, the latency and the last error of each dependency.
+// @description
+// @id GetHealthDetails
+// @produce json
+// @success 200 {object} presentity.Health
+// @failure 401 {object} error.Error
+// @failure 503 {object} presentity.Health
+// @router /health/details [GET]
+// @security ApiKeyAuth
+func (h *Handler) GetHealthDetails(w http.ResponseWriter, r *http.Request) {
+	h.respondWithHealth(w, r, true)
+}
+
+// respondWithHealth is the function that checks the dependencies and responds with 503 if any of them fails.
+// The checks of the components and the statistics of the database connection pool are included only when withChecks is true,
+// so that they are not exposed publicly. The statistics are left out if they cannot be got, which the database check reports.
+func (h *Handler) respondWithHealth(w http.ResponseWriter, r *http.Request, withChecks bool) {
+	report := h.HealthCheckService.GetHealth(r.Context())
+
+	health := presentableentity.Health{}
+	health.FromReport(report, withChecks)
+
+	if withChecks {
+		if dbStats, err := h.HealthCheckService.GetDBStats(); err == nil {
+			health.Database = &presentableentity.DBStats{}
+			health.Database.FromDomain(dbStats)
+		}
+	}
+
+	statusCode := http.StatusOK
+	if !report.IsHealthy() {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	responsehttputilpkg.RespondWithJSON(w, statusCode, health)
+}
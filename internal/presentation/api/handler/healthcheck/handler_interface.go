@@ -5,4 +5,7 @@ import "net/http"
 // IHandler interface is the healthcheck's handler contract.
 type IHandler interface {
 	GetStatus(w http.ResponseWriter, r *http.Request)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	GetHealthDetails(w http.ResponseWriter, r *http.Request)
 }
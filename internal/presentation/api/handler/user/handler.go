@@ -25,15 +25,15 @@ func New(userService userservice.IService) IHandler {
 // @description
 // @id GetAllUsers
 // @produce json
-// @success 200 {array} model.User
+// @success 200 {array} presentity.User
 // @failure 401 {object} error.Error
 // @failure 500 {object} error.Error
-// @router /users [GET]
+// @router /v1/users [GET]
 // @security ApiKeyAuth
 func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
-	domainUsers, err := h.UserService.WithDBTrx(nil).GetAll()
+	domainUsers, err := h.UserService.WithDBTrx(nil).GetAll(r.Context())
 	if err != nil {
-		responsehttputilpkg.RespondErrorWithJSON(w, err)
+		responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 		return
 	}
 
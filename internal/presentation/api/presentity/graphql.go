@@ -0,0 +1,53 @@
+package presentity
+
+import (
+	"github.com/graphql-go/graphql"
+	gqlerrorgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/gqlerror"
+)
+
+// GraphQLRequest is the representation of the GraphQL request's http model.
+type GraphQLRequest struct {
+	Query         string                 `validate:"nonzero" json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLErrorLocation is the representation of the location in the query of a GraphQL error's http model.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLError is the representation of the GraphQL error's http model.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLResponse is the representation of the GraphQL response's http model.
+// The data is left out when the query is not executed, such as when it is not valid.
+type GraphQLResponse struct {
+	Data   interface{}    `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// FromResult is the function that builds a http model based on the result of a GraphQL query.
+func (g *GraphQLResponse) FromResult(result *graphql.Result) {
+	g.Data = result.Data
+
+	for _, formattedError := range result.Errors {
+		graphQLError := GraphQLError{
+			Message:    formattedError.Message,
+			Path:       formattedError.Path,
+			Extensions: gqlerrorgraphqlutilpkg.Extensions(formattedError),
+		}
+
+		for _, location := range formattedError.Locations {
+			graphQLError.Locations = append(graphQLError.Locations, GraphQLErrorLocation{Line: location.Line, Column: location.Column})
+		}
+
+		g.Errors = append(g.Errors, graphQLError)
+	}
+}
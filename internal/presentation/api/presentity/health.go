@@ -0,0 +1,80 @@
+package presentity
+
+import (
+	"database/sql"
+	"time"
+
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+)
+
+// Health is the representation of health's http model.
+type Health struct {
+	Status   string                     `json:"status"`
+	Checks   map[string]ComponentHealth `json:"checks,omitempty"`
+	Database *DBStats                   `json:"database,omitempty"`
+}
+
+// ComponentHealth is the representation of the health of a dependency's http model.
+type ComponentHealth struct {
+	Status      string     `json:"status"`
+	LatencyInMs float64    `json:"latency_in_ms"`
+	CheckedAt   time.Time  `json:"checked_at"`
+	Error       string     `json:"error,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// DBStats is the representation of the database connection pool statistics' http model.
+type DBStats struct {
+	MaxOpenConnections int   `json:"max_open_connections"`
+	OpenConnections    int   `json:"open_connections"`
+	InUse              int   `json:"in_use"`
+	Idle               int   `json:"idle"`
+	WaitCount          int64 `json:"wait_count"`
+	WaitDurationInMs   int64 `json:"wait_duration_in_ms"`
+	MaxIdleClosed      int64 `json:"max_idle_closed"`
+	MaxIdleTimeClosed  int64 `json:"max_idle_time_closed"`
+	MaxLifetimeClosed  int64 `json:"max_lifetime_closed"`
+}
+
+// FromReport is the function that builds a http model based on the report of the health checks.
+// The checks of the components are included only when withChecks is true, so that they are not exposed publicly.
+func (h *Health) FromReport(report healthpkg.Report, withChecks bool) {
+	h.Status = report.Status
+
+	if !withChecks {
+		return
+	}
+
+	h.Checks = make(map[string]ComponentHealth)
+
+	for _, component := range report.Components {
+		componentHealth := ComponentHealth{
+			Status:      component.Status,
+			LatencyInMs: float64(component.Latency.Microseconds()) / 1000,
+			CheckedAt:   component.CheckedAt.UTC(),
+			Error:       component.Error,
+			LastError:   component.LastError,
+		}
+
+		if !component.LastErrorAt.IsZero() {
+			lastErrorAt := component.LastErrorAt.UTC()
+			componentHealth.LastErrorAt = &lastErrorAt
+		}
+
+		h.Checks[component.Name] = componentHealth
+	}
+}
+
+// FromDomain is the function that builds a http model based on the database connection pool statistics.
+func (d *DBStats) FromDomain(stats sql.DBStats) {
+	d.MaxOpenConnections = stats.MaxOpenConnections
+	d.OpenConnections = stats.OpenConnections
+	d.InUse = stats.InUse
+	d.Idle = stats.Idle
+	d.WaitCount = stats.WaitCount
+	d.WaitDurationInMs = stats.WaitDuration.Milliseconds()
+	d.MaxIdleClosed = stats.MaxIdleClosed
+	d.MaxIdleTimeClosed = stats.MaxIdleTimeClosed
+	d.MaxLifetimeClosed = stats.MaxLifetimeClosed
+}
@@ -0,0 +1,18 @@
+package presentity
+
+import (
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Login is the representation of login's http model, which does not expose the password.
+type Login struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// FromDomain is the function that builds a http model based on the model's data from domain.
+func (l *Login) FromDomain(login domainentity.Login) {
+	l.UserID = login.UserID
+	l.Username = login.Username
+}
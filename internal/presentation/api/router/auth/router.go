@@ -4,47 +4,109 @@ import (
 	"net/http"
 
 	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+)
+
+// The names of the rate limit policies of the auth's routes.
+const (
+	RateLimitPolicySignUp         = "sign_up"
+	RateLimitPolicySignIn         = "sign_in"
+	RateLimitPolicyChangePassword = "change_password"
 )
 
 // ConfigureRoutes is the function that arranges the auth's routes.
-func ConfigureRoutes(authHandler authhandler.IHandler, adapters map[string]adapterhttputilpkg.Adapter) routehttputilpkg.Routes {
+func ConfigureRoutes(authHandler authhandler.IHandler) routehttputilpkg.Routes {
 	return routehttputilpkg.Routes{
 		routehttputilpkg.Route{
-			Name:   "SignUp",
-			Method: http.MethodPost,
-			Path:   "/sign_up",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignUp).
-				With(adapters["loggingMiddleware"], adapters["dbTrxMiddleware"]),
+			Name:            "SignUp",
+			Method:          http.MethodPost,
+			Version:         routehttputilpkg.V1,
+			Path:            "/sign_up",
+			HandlerFunc:     authHandler.SignUp,
+			RateLimitPolicy: RateLimitPolicySignUp,
+			Transaction:     routehttputilpkg.TransactionReadWrite,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to perform sign up.",
+				Tags:      []string{"authentication"},
+				Request:   securitypkg.Credentials{},
+				Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+			},
+		},
+		routehttputilpkg.Route{
+			Name:            "SignIn",
+			Method:          http.MethodPost,
+			Version:         routehttputilpkg.V1,
+			Path:            "/sign_in",
+			HandlerFunc:     authHandler.SignIn,
+			RateLimitPolicy: RateLimitPolicySignIn,
+			Transaction:     routehttputilpkg.TransactionReadWrite,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to perform sign in.",
+				Tags:      []string{"authentication"},
+				Request:   securitypkg.Credentials{},
+				Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+			},
 		},
 		routehttputilpkg.Route{
-			Name:   "SignIn",
-			Method: http.MethodPost,
-			Path:   "/sign_in",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignIn).
-				With(adapters["loggingMiddleware"], adapters["dbTrxMiddleware"]),
+			Name:        "RefreshToken",
+			Method:      http.MethodPost,
+			Version:     routehttputilpkg.V1,
+			Path:        "/refresh_token",
+			HandlerFunc: authHandler.RefreshToken,
+			Auth:        routehttputilpkg.AuthTokenRenewal,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to refresh the access token.",
+				Tags:      []string{"authentication"},
+				Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+			},
 		},
 		routehttputilpkg.Route{
-			Name:   "RefreshToken",
-			Method: http.MethodPost,
-			Path:   "/refresh_token",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.RefreshToken).
-				With(adapters["loggingMiddleware"], adapters["authRenewalMiddleware"]),
+			Name:        "GetLogin",
+			Method:      http.MethodGet,
+			Version:     routehttputilpkg.V1,
+			Path:        "/login",
+			HandlerFunc: authHandler.GetLogin,
+			Auth:        routehttputilpkg.AuthToken,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to get the login of the authenticated user.",
+				Tags:      []string{"authentication"},
+				Responses: map[int]interface{}{http.StatusOK: presentableentity.Login{}},
+			},
 		},
 		routehttputilpkg.Route{
-			Name:   "ChangePassword",
-			Method: http.MethodPost,
-			Path:   "/change_password",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.ChangePassword).
-				With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+			Name:            "ChangePassword",
+			Method:          http.MethodPost,
+			Version:         routehttputilpkg.V1,
+			Path:            "/change_password",
+			HandlerFunc:     authHandler.ChangePassword,
+			Auth:            routehttputilpkg.AuthToken,
+			RateLimitPolicy: RateLimitPolicyChangePassword,
+			Doc: &routehttputilpkg.Doc{
+				Summary: "API endpoint to reset the user's password.",
+				Tags:    []string{"authentication"},
+				Request: securitypkg.Passwords{},
+				Headers: map[string]string{
+					"If-Match": "Entity tags of the login, as returned by GET /v1/login or a previous password change",
+				},
+				Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+			},
 		},
 		routehttputilpkg.Route{
-			Name:   "SignOut",
-			Method: http.MethodPost,
-			Path:   "/sign_out",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignOut).
-				With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+			Name:        "SignOut",
+			Method:      http.MethodPost,
+			Version:     routehttputilpkg.V1,
+			Path:        "/sign_out",
+			HandlerFunc: authHandler.SignOut,
+			Auth:        routehttputilpkg.AuthToken,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to perform sign out.",
+				Tags:      []string{"authentication"},
+				Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+			},
 		},
 	}
 }
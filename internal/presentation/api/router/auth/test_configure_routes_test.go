@@ -8,73 +8,112 @@ import (
 
 	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
 	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
 	authrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/auth"
-	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
-	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
-	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
-	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/gorm"
 )
 
 func (ts *TestSuite) TestConfigureRoutes() {
 	routes := routehttputilpkg.Routes{}
 
-	db := &gorm.DB{}
-	timeBeforeTokenExpTimeInSec := 10
-	authN := authpkg.New(authpkg.RSAKeys{})
-
 	authService := new(authmockservice.Service)
 	authHandler := authhandler.New(authService)
 
-	adapters := map[string]adapterhttputilpkg.Adapter{
-		"loggingMiddleware":     loggingmiddlewarepkg.Logging(),
-		"authMiddleware":        authmiddlewarepkg.Auth(db, authN),
-		"authRenewalMiddleware": authmiddlewarepkg.AuthRenewal(db, authN, timeBeforeTokenExpTimeInSec),
-		"dbTrxMiddleware":       dbtrxmiddlewarepkg.DBTrx(db),
-	}
-
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInConfiguringTheRoutes",
 			SetUp: func(t *testing.T) {
 				routes = routehttputilpkg.Routes{
 					routehttputilpkg.Route{
-						Name:   "SignUp",
-						Method: http.MethodPost,
-						Path:   "/sign_up",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignUp).
-							With(adapters["loggingMiddleware"], adapters["dbTrxMiddleware"]),
+						Name:            "SignUp",
+						Method:          http.MethodPost,
+						Version:         routehttputilpkg.V1,
+						Path:            "/sign_up",
+						HandlerFunc:     authHandler.SignUp,
+						RateLimitPolicy: authrouter.RateLimitPolicySignUp,
+						Transaction:     routehttputilpkg.TransactionReadWrite,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to perform sign up.",
+							Tags:      []string{"authentication"},
+							Request:   securitypkg.Credentials{},
+							Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+						},
+					},
+					routehttputilpkg.Route{
+						Name:            "SignIn",
+						Method:          http.MethodPost,
+						Version:         routehttputilpkg.V1,
+						Path:            "/sign_in",
+						HandlerFunc:     authHandler.SignIn,
+						RateLimitPolicy: authrouter.RateLimitPolicySignIn,
+						Transaction:     routehttputilpkg.TransactionReadWrite,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to perform sign in.",
+							Tags:      []string{"authentication"},
+							Request:   securitypkg.Credentials{},
+							Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+						},
 					},
 					routehttputilpkg.Route{
-						Name:   "SignIn",
-						Method: http.MethodPost,
-						Path:   "/sign_in",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignIn).
-							With(adapters["loggingMiddleware"], adapters["dbTrxMiddleware"]),
+						Name:        "RefreshToken",
+						Method:      http.MethodPost,
+						Version:     routehttputilpkg.V1,
+						Path:        "/refresh_token",
+						HandlerFunc: authHandler.RefreshToken,
+						Auth:        routehttputilpkg.AuthTokenRenewal,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to refresh the access token.",
+							Tags:      []string{"authentication"},
+							Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+						},
 					},
 					routehttputilpkg.Route{
-						Name:   "RefreshToken",
-						Method: http.MethodPost,
-						Path:   "/refresh_token",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.RefreshToken).
-							With(adapters["loggingMiddleware"], adapters["authRenewalMiddleware"]),
+						Name:        "GetLogin",
+						Method:      http.MethodGet,
+						Version:     routehttputilpkg.V1,
+						Path:        "/login",
+						HandlerFunc: authHandler.GetLogin,
+						Auth:        routehttputilpkg.AuthToken,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to get the login of the authenticated user.",
+							Tags:      []string{"authentication"},
+							Responses: map[int]interface{}{http.StatusOK: presentableentity.Login{}},
+						},
 					},
 					routehttputilpkg.Route{
-						Name:   "ChangePassword",
-						Method: http.MethodPost,
-						Path:   "/change_password",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.ChangePassword).
-							With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+						Name:            "ChangePassword",
+						Method:          http.MethodPost,
+						Version:         routehttputilpkg.V1,
+						Path:            "/change_password",
+						HandlerFunc:     authHandler.ChangePassword,
+						Auth:            routehttputilpkg.AuthToken,
+						RateLimitPolicy: authrouter.RateLimitPolicyChangePassword,
+						Doc: &routehttputilpkg.Doc{
+							Summary: "API endpoint to reset the user's password.",
+							Tags:    []string{"authentication"},
+							Request: securitypkg.Passwords{},
+							Headers: map[string]string{
+								"If-Match": "Entity tags of the login, as returned by GET /v1/login or a previous password change",
+							},
+							Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+						},
 					},
 					routehttputilpkg.Route{
-						Name:   "SignOut",
-						Method: http.MethodPost,
-						Path:   "/sign_out",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(authHandler.SignOut).
-							With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+						Name:        "SignOut",
+						Method:      http.MethodPost,
+						Version:     routehttputilpkg.V1,
+						Path:        "/sign_out",
+						HandlerFunc: authHandler.SignOut,
+						Auth:        routehttputilpkg.AuthToken,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to perform sign out.",
+							Tags:      []string{"authentication"},
+							Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+						},
 					},
 				}
 			},
@@ -85,17 +124,17 @@ func (ts *TestSuite) TestConfigureRoutes() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			returnedRoutes := authrouter.ConfigureRoutes(authHandler, adapters)
+			returnedRoutes := authrouter.ConfigureRoutes(authHandler)
 
 			assert.Equal(t, len(routes), len(returnedRoutes))
 
 			for i := range routes {
-				assert.Equal(t, routes[i].Name, returnedRoutes[i].Name)
-				assert.Equal(t, routes[i].Method, returnedRoutes[i].Method)
-				assert.Equal(t, routes[i].Path, returnedRoutes[i].Path)
 				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
 				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
 				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
 			}
 		})
 	}
@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"net/http"
+
+	graphqlhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/graphql"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+)
+
+// ConfigureRoutes is the function that arranges the GraphQL's routes.
+// The endpoint is not versioned, since the schema evolves by adding fields rather than by versions.
+func ConfigureRoutes(graphQLHandler graphqlhandler.IHandler) routehttputilpkg.Routes {
+	return routehttputilpkg.Routes{
+		routehttputilpkg.Route{
+			Name:        "GraphQL",
+			Method:      http.MethodPost,
+			Path:        "/graphql",
+			HandlerFunc: graphQLHandler.Query,
+			Auth:        routehttputilpkg.AuthToken,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to query the users and their sessions with GraphQL.",
+				Tags:      []string{"graphql"},
+				Request:   presentableentity.GraphQLRequest{},
+				Responses: map[int]interface{}{http.StatusOK: presentableentity.GraphQLResponse{}},
+			},
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package graphql_test
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"testing"
+
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	graphqlhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/graphql"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	graphqlrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/graphql"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestConfigureRoutes() {
+	routes := routehttputilpkg.Routes{}
+
+	userService := new(usermockservice.Service)
+	authService := new(authmockservice.Service)
+
+	graphQLHandler, err := graphqlhandler.New(userService, authService, graphqlhandler.Config{DefaultPageSize: 20, MaxPageSize: 100})
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInConfiguringTheRoutes",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					routehttputilpkg.Route{
+						Name:        "GraphQL",
+						Method:      http.MethodPost,
+						Path:        "/graphql",
+						HandlerFunc: graphQLHandler.Query,
+						Auth:        routehttputilpkg.AuthToken,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to query the users and their sessions with GraphQL.",
+							Tags:      []string{"graphql"},
+							Request:   presentableentity.GraphQLRequest{},
+							Responses: map[int]interface{}{http.StatusOK: presentableentity.GraphQLResponse{}},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRoutes := graphqlrouter.ConfigureRoutes(graphQLHandler)
+
+			assert.Equal(t, len(routes), len(returnedRoutes))
+
+			for i := range routes {
+				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
+				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
+				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
+			}
+		})
+	}
+}
@@ -4,19 +4,67 @@ import (
 	"net/http"
 
 	healthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/healthcheck"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
 )
 
 // ConfigureRoutes is the function that arranges the healthcheck's routes.
-func ConfigureRoutes(healthCheckHandler healthcheckhandler.IHandler, adapters map[string]adapterhttputilpkg.Adapter) routehttputilpkg.Routes {
+// The liveness and readiness probes are not logged nor traced, since they are requested every few seconds.
+func ConfigureRoutes(healthCheckHandler healthcheckhandler.IHandler) routehttputilpkg.Routes {
 	return routehttputilpkg.Routes{
 		routehttputilpkg.Route{
-			Name:   "GetStatus",
-			Method: http.MethodGet,
-			Path:   "/status",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(healthCheckHandler.GetStatus).
-				With(adapters["loggingMiddleware"]),
+			Name:        "GetStatus",
+			Method:      http.MethodGet,
+			Path:        "/status",
+			HandlerFunc: healthCheckHandler.GetStatus,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint used to verify if the service has started up correctly and is ready to accept requests.",
+				Tags:      []string{"health check"},
+				Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+			},
+		},
+		routehttputilpkg.Route{
+			Name:            "GetLiveness",
+			Method:          http.MethodGet,
+			Path:            "/livez",
+			HandlerFunc:     healthCheckHandler.GetLiveness,
+			Instrumentation: routehttputilpkg.InstrumentationMetrics,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint used to verify if the process is alive, regardless of its dependencies.",
+				Tags:      []string{"health check"},
+				Responses: map[int]interface{}{http.StatusOK: presentableentity.Health{}},
+			},
+		},
+		routehttputilpkg.Route{
+			Name:            "GetReadiness",
+			Method:          http.MethodGet,
+			Path:            "/readyz",
+			HandlerFunc:     healthCheckHandler.GetReadiness,
+			Instrumentation: routehttputilpkg.InstrumentationMetrics,
+			Doc: &routehttputilpkg.Doc{
+				Summary: "API endpoint used to verify if the service is ready to accept requests, based on the health of its dependencies.",
+				Tags:    []string{"health check"},
+				Responses: map[int]interface{}{
+					http.StatusOK:                 presentableentity.Health{},
+					http.StatusServiceUnavailable: presentableentity.Health{},
+				},
+			},
+		},
+		routehttputilpkg.Route{
+			Name:        "GetHealthDetails",
+			Method:      http.MethodGet,
+			Path:        "/health/details",
+			HandlerFunc: healthCheckHandler.GetHealthDetails,
+			Auth:        routehttputilpkg.AuthToken,
+			Doc: &routehttputilpkg.Doc{
+				Summary: "API endpoint used to get the status, the latency and the last error of each dependency.",
+				Tags:    []string{"health check"},
+				Responses: map[int]interface{}{
+					http.StatusOK:                 presentableentity.Health{},
+					http.StatusServiceUnavailable: presentableentity.Health{},
+				},
+			},
 		},
 	}
 }
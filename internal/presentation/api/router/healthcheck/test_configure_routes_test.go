@@ -8,10 +8,10 @@ import (
 
 	healthcheckmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/healthcheck"
 	healthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/healthcheck"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
 	healthcheckrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/healthcheck"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
-	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,21 +21,63 @@ func (ts *TestSuite) TestConfigureRoutes() {
 	healthCheckService := new(healthcheckmockservice.Service)
 	healthCheckHandler := healthcheckhandler.New(healthCheckService)
 
-	adapters := map[string]adapterhttputilpkg.Adapter{
-		"loggingMiddleware": loggingmiddlewarepkg.Logging(),
-	}
-
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInConfiguringTheRoutes",
 			SetUp: func(t *testing.T) {
 				routes = routehttputilpkg.Routes{
 					routehttputilpkg.Route{
-						Name:   "GetStatus",
-						Method: http.MethodGet,
-						Path:   "/status",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(healthCheckHandler.GetStatus).
-							With(adapters["loggingMiddleware"]),
+						Name:        "GetStatus",
+						Method:      http.MethodGet,
+						Path:        "/status",
+						HandlerFunc: healthCheckHandler.GetStatus,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint used to verify if the service has started up correctly and is ready to accept requests.",
+							Tags:      []string{"health check"},
+							Responses: map[int]interface{}{http.StatusOK: responsehttputilpkg.Message{}},
+						},
+					},
+					routehttputilpkg.Route{
+						Name:            "GetLiveness",
+						Method:          http.MethodGet,
+						Path:            "/livez",
+						HandlerFunc:     healthCheckHandler.GetLiveness,
+						Instrumentation: routehttputilpkg.InstrumentationMetrics,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint used to verify if the process is alive, regardless of its dependencies.",
+							Tags:      []string{"health check"},
+							Responses: map[int]interface{}{http.StatusOK: presentableentity.Health{}},
+						},
+					},
+					routehttputilpkg.Route{
+						Name:            "GetReadiness",
+						Method:          http.MethodGet,
+						Path:            "/readyz",
+						HandlerFunc:     healthCheckHandler.GetReadiness,
+						Instrumentation: routehttputilpkg.InstrumentationMetrics,
+						Doc: &routehttputilpkg.Doc{
+							Summary: "API endpoint used to verify if the service is ready to accept requests, based on the health of its dependencies.",
+							Tags:    []string{"health check"},
+							Responses: map[int]interface{}{
+								http.StatusOK:                 presentableentity.Health{},
+								http.StatusServiceUnavailable: presentableentity.Health{},
+							},
+						},
+					},
+					routehttputilpkg.Route{
+						Name:        "GetHealthDetails",
+						Method:      http.MethodGet,
+						Path:        "/health/details",
+						HandlerFunc: healthCheckHandler.GetHealthDetails,
+						Auth:        routehttputilpkg.AuthToken,
+						Doc: &routehttputilpkg.Doc{
+							Summary: "API endpoint used to get the status, the latency and the last error of each dependency.",
+							Tags:    []string{"health check"},
+							Responses: map[int]interface{}{
+								http.StatusOK:                 presentableentity.Health{},
+								http.StatusServiceUnavailable: presentableentity.Health{},
+							},
+						},
 					},
 				}
 			},
@@ -46,17 +88,17 @@ func (ts *TestSuite) TestConfigureRoutes() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			returnedRoutes := healthcheckrouter.ConfigureRoutes(healthCheckHandler, adapters)
+			returnedRoutes := healthcheckrouter.ConfigureRoutes(healthCheckHandler)
 
 			assert.Equal(t, len(routes), len(returnedRoutes))
 
 			for i := range routes {
-				assert.Equal(t, routes[i].Name, returnedRoutes[i].Name)
-				assert.Equal(t, routes[i].Method, returnedRoutes[i].Method)
-				assert.Equal(t, routes[i].Path, returnedRoutes[i].Path)
 				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
 				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
 				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
 			}
 		})
 	}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"net/http"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+)
+
+// ConfigureRoutes is the function that arranges the metrics' routes.
+// The scrapes are not instrumented so as not to pollute the metrics and the logs.
+func ConfigureRoutes(metricsHandler http.HandlerFunc) routehttputilpkg.Routes {
+	return routehttputilpkg.Routes{
+		routehttputilpkg.Route{
+			Name:            "GetMetrics",
+			Method:          http.MethodGet,
+			Path:            "/metrics",
+			HandlerFunc:     metricsHandler,
+			Instrumentation: routehttputilpkg.InstrumentationNone,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint used to scrape the metrics in the Prometheus text format.",
+				Tags:      []string{"metrics"},
+				Responses: map[int]interface{}{http.StatusOK: nil},
+			},
+		},
+	}
+}
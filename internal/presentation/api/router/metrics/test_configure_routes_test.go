@@ -0,0 +1,60 @@
+package metrics_test
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"testing"
+
+	metricsrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/metrics"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestConfigureRoutes() {
+	routes := routehttputilpkg.Routes{}
+
+	metricsHandler := metricspkg.Handler().ServeHTTP
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInConfiguringTheRoutes",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					routehttputilpkg.Route{
+						Name:            "GetMetrics",
+						Method:          http.MethodGet,
+						Path:            "/metrics",
+						HandlerFunc:     metricsHandler,
+						Instrumentation: routehttputilpkg.InstrumentationNone,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint used to scrape the metrics in the Prometheus text format.",
+							Tags:      []string{"metrics"},
+							Responses: map[int]interface{}{http.StatusOK: nil},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRoutes := metricsrouter.ConfigureRoutes(metricsHandler)
+
+			assert.Equal(t, len(routes), len(returnedRoutes))
+
+			for i := range routes {
+				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
+				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
+				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package openapi
+
+import (
+	"net/http"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+)
+
+// ConfigureRoutes is the function that arranges the OpenAPI's routes.
+func ConfigureRoutes(openAPIHandler http.HandlerFunc) routehttputilpkg.Routes {
+	return routehttputilpkg.Routes{
+		routehttputilpkg.Route{
+			Name:        "GetOpenAPI",
+			Method:      http.MethodGet,
+			Path:        "/openapi.json",
+			HandlerFunc: openAPIHandler,
+		},
+	}
+}
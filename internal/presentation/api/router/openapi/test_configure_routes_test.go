@@ -0,0 +1,54 @@
+package openapi_test
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"testing"
+
+	openapirouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/openapi"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestConfigureRoutes() {
+	routes := routehttputilpkg.Routes{}
+
+	openAPIHandler := openapipkg.Handler(&openapipkg.Document{})
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInConfiguringTheRoutes",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					routehttputilpkg.Route{
+						Name:        "GetOpenAPI",
+						Method:      http.MethodGet,
+						Path:        "/openapi.json",
+						HandlerFunc: openAPIHandler,
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRoutes := openapirouter.ConfigureRoutes(openAPIHandler)
+
+			assert.Equal(t, len(routes), len(returnedRoutes))
+
+			for i := range routes {
+				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
+				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
+				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	deprecationmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/deprecation"
+	timeoutmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/timeout"
+)
+
+// Middlewares are the middlewares the chains of the routes are composed of.
+// A middleware that no route requires can be left nil.
+type Middlewares struct {
+	Metrics adapterhttputilpkg.Adapter
+	Logging adapterhttputilpkg.Adapter
+	Tracing adapterhttputilpkg.Adapter
+	// Recovery is the middleware that recovers from the panics of the routes inside their instrumentation,
+	// so that they are measured and logged as internal server errors. The panics are not recovered if it is nil.
+	Recovery    adapterhttputilpkg.Adapter
+	Auth        adapterhttputilpkg.Adapter
+	AuthRenewal adapterhttputilpkg.Adapter
+	// Authorize is the function that builds the middleware that checks if the authenticated user has the permissions.
+	Authorize func(permissions []string) adapterhttputilpkg.Adapter
+	DBTrx     adapterhttputilpkg.Adapter
+	// Validation is the function that builds the middleware that validates the requests and the responses of a route
+	// against the API specification. The routes are not validated if it is nil.
+	Validation func(route routehttputilpkg.Route) adapterhttputilpkg.Adapter
+	// RateLimits are the middlewares of the rate limit policies by name.
+	// A policy mapped to nil is disabled, so that its routes are not throttled.
+	RateLimits map[string]adapterhttputilpkg.Adapter
+}
+
+// Build is the function that composes the chain of middlewares of each route from its requirements, in the order:
+//  1. metrics, logging and tracing, according to the instrumentation of the route;
+//  2. recovery, so that the response of a panic is seen by the instrumentation;
+//  3. deprecation, which signals the deprecated routes;
+//  4. timeout, so that everything past it is aborted when it expires;
+//  5. auth or auth renewal;
+//  6. authorization of the permissions of the authenticated user;
+//  7. rate limit, after the auth so that the policies keyed by user throttle the authenticated one;
+//  8. validation against the API specification, so that the rejected requests do not open a transaction;
+//  9. database transaction, which only spans the handler.
+//
+// It fails with all the misconfigurations found, such as a middleware that is required but not configured,
+// an unknown rate limit policy or two routes with the same method and path, so that the application does not start.
+func Build(routes routehttputilpkg.Routes, middlewares Middlewares) (routehttputilpkg.Routes, error) {
+	builtRoutes := make(routehttputilpkg.Routes, 0, len(routes))
+
+	errs := make([]error, 0)
+
+	paths := make(map[string]string)
+
+	for _, route := range routes {
+		path := route.FullPath()
+		if path == "" {
+			path = route.FullPathPrefix() + "/*"
+		}
+
+		key := route.Method + " " + path
+		if name, ok := paths[key]; ok {
+			errs = append(errs, fmt.Errorf("the route %s has the same method and path as the route %s: %s", route.Name, name, key))
+		}
+
+		paths[key] = route.Name
+
+		adapters, err := chain(route, middlewares)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		route.HandlerFunc = adapterhttputilpkg.AdaptFunc(route.HandlerFunc).With(adapters...)
+
+		builtRoutes = append(builtRoutes, route)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return builtRoutes, nil
+}
+
+// chain is the function that lists the middlewares a route requires, in the order they wrap its handler.
+func chain(route routehttputilpkg.Route, middlewares Middlewares) ([]adapterhttputilpkg.Adapter, error) {
+	errs := make([]error, 0)
+
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("the route %s %s", route.Name, fmt.Sprintf(format, args...)))
+	}
+
+	if route.Name == "" || route.Method == "" || (route.Path == "" && route.PathPrefix == "") {
+		fail("must have a name, a method and a path or a path prefix")
+	}
+
+	if route.HandlerFunc == nil {
+		fail("has no handler")
+	}
+
+	adapters := make([]adapterhttputilpkg.Adapter, 0)
+
+	require := func(adapter adapterhttputilpkg.Adapter, name string) {
+		if adapter == nil {
+			fail("requires the %s middleware, which is not configured", name)
+			return
+		}
+
+		adapters = append(adapters, adapter)
+	}
+
+	switch route.Instrumentation {
+	case routehttputilpkg.InstrumentationFull:
+		require(middlewares.Metrics, "metrics")
+		require(middlewares.Logging, "logging")
+		require(middlewares.Tracing, "tracing")
+	case routehttputilpkg.InstrumentationMetrics:
+		require(middlewares.Metrics, "metrics")
+	case routehttputilpkg.InstrumentationNone:
+	default:
+		fail("has an unknown instrumentation: %s", route.Instrumentation)
+	}
+
+	if middlewares.Recovery != nil {
+		adapters = append(adapters, middlewares.Recovery)
+	}
+
+	if route.Deprecation != nil {
+		adapters = append(adapters, deprecationmiddlewarepkg.Deprecation(route))
+	}
+
+	if route.Timeout < 0 {
+		fail("has a negative timeout: %s", route.Timeout)
+	} else if route.Timeout > 0 {
+		adapters = append(adapters, timeoutmiddlewarepkg.Timeout(route.Timeout))
+	}
+
+	switch route.Auth {
+	case routehttputilpkg.AuthNone:
+	case routehttputilpkg.AuthToken:
+		require(middlewares.Auth, "auth")
+	case routehttputilpkg.AuthTokenRenewal:
+		require(middlewares.AuthRenewal, "auth renewal")
+	default:
+		fail("has an unknown auth requirement: %s", route.Auth)
+	}
+
+	if len(route.Permissions) > 0 {
+		switch {
+		case route.Auth == routehttputilpkg.AuthNone:
+			fail("requires permissions but no authentication")
+		case middlewares.Authorize == nil:
+			fail("requires permissions, but the authorization middleware is not configured")
+		default:
+			adapters = append(adapters, middlewares.Authorize(route.Permissions))
+		}
+	}
+
+	if route.RateLimitPolicy != "" {
+		rateLimit, ok := middlewares.RateLimits[route.RateLimitPolicy]
+		if !ok {
+			fail("has an unknown rate limit policy: %s", route.RateLimitPolicy)
+		} else if rateLimit != nil {
+			adapters = append(adapters, rateLimit)
+		}
+	}
+
+	if middlewares.Validation != nil {
+		adapters = append(adapters, middlewares.Validation(route))
+	}
+
+	switch route.Transaction {
+	case routehttputilpkg.TransactionNone:
+	case routehttputilpkg.TransactionReadWrite:
+		require(middlewares.DBTrx, "database transaction")
+	default:
+		fail("has an unknown transaction mode: %s", route.Transaction)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return adapters, nil
+}
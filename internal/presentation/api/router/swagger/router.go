@@ -3,19 +3,17 @@ package swagger
 import (
 	"net/http"
 
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
 )
 
 // ConfigureRoutes is the function that arranges the swagger's routes.
-func ConfigureRoutes(swaggerHandler http.HandlerFunc, adapters map[string]adapterhttputilpkg.Adapter) routehttputilpkg.Routes {
+func ConfigureRoutes(swaggerHandler http.HandlerFunc) routehttputilpkg.Routes {
 	return routehttputilpkg.Routes{
 		routehttputilpkg.Route{
-			Name:       "Swagger",
-			Method:     http.MethodGet,
-			PathPrefix: "/swagger",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(swaggerHandler).
-				With(adapters["loggingMiddleware"]),
+			Name:        "Swagger",
+			Method:      http.MethodGet,
+			PathPrefix:  "/swagger",
+			HandlerFunc: swaggerHandler,
 		},
 	}
 }
@@ -7,9 +7,7 @@ import (
 	"testing"
 
 	swaggerrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/swagger"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
-	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
 	"github.com/stretchr/testify/assert"
 	httpswaggerpkg "github.com/swaggo/http-swagger"
 )
@@ -19,21 +17,16 @@ func (ts *TestSuite) TestConfigureRoutes() {
 
 	swaggerHandler := httpswaggerpkg.WrapHandler
 
-	adapters := map[string]adapterhttputilpkg.Adapter{
-		"loggingMiddleware": loggingmiddlewarepkg.Logging(),
-	}
-
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInConfiguringTheRoutes",
 			SetUp: func(t *testing.T) {
 				routes = routehttputilpkg.Routes{
 					routehttputilpkg.Route{
-						Name:       "Swagger",
-						Method:     http.MethodGet,
-						PathPrefix: "/swagger",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(swaggerHandler).
-							With(adapters["loggingMiddleware"]),
+						Name:        "Swagger",
+						Method:      http.MethodGet,
+						PathPrefix:  "/swagger",
+						HandlerFunc: swaggerHandler,
 					},
 				}
 			},
@@ -44,17 +37,17 @@ func (ts *TestSuite) TestConfigureRoutes() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			returnedRoutes := swaggerrouter.ConfigureRoutes(swaggerHandler, adapters)
+			returnedRoutes := swaggerrouter.ConfigureRoutes(swaggerHandler)
 
 			assert.Equal(t, len(routes), len(returnedRoutes))
 
 			for i := range routes {
-				assert.Equal(t, routes[i].Name, returnedRoutes[i].Name)
-				assert.Equal(t, routes[i].Method, returnedRoutes[i].Method)
-				assert.Equal(t, routes[i].Path, returnedRoutes[i].Path)
 				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
 				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
 				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
 			}
 		})
 	}
@@ -0,0 +1,157 @@
+package router_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apirouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router"
+	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestBuild() {
+	routes := routehttputilpkg.Routes{}
+
+	middlewares := apirouter.Middlewares{}
+
+	calls := make([]string, 0)
+
+	chain := []string{}
+
+	errorMessages := []string{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "handler")
+	}
+
+	// record is the function that builds a middleware that records its name when it is called.
+	record := func(name string) adapterhttputilpkg.Adapter {
+		return func(next http.HandlerFunc) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, r)
+			}
+		}
+	}
+
+	allMiddlewares := apirouter.Middlewares{
+		Metrics:     record("metrics"),
+		Logging:     record("logging"),
+		Tracing:     record("tracing"),
+		Recovery:    record("recovery"),
+		Auth:        record("auth"),
+		AuthRenewal: record("authRenewal"),
+		Authorize: func(permissions []string) adapterhttputilpkg.Adapter {
+			return record("authorize:" + strings.Join(permissions, ","))
+		},
+		DBTrx: record("dbTrx"),
+		Validation: func(route routehttputilpkg.Route) adapterhttputilpkg.Adapter {
+			return record("validation:" + route.Name)
+		},
+		RateLimits: map[string]adapterhttputilpkg.Adapter{
+			"sign_up":  record("rateLimit:sign_up"),
+			"disabled": nil,
+		},
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInComposingTheMiddlewaresInTheDocumentedOrder",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{
+						Name:            "ChangePassword",
+						Method:          http.MethodPost,
+						Path:            "/change_password",
+						HandlerFunc:     handler,
+						Auth:            routehttputilpkg.AuthToken,
+						Permissions:     []string{"password:write"},
+						Transaction:     routehttputilpkg.TransactionReadWrite,
+						RateLimitPolicy: "sign_up",
+						Timeout:         time.Second,
+					},
+				}
+				middlewares = allMiddlewares
+				chain = []string{"metrics", "logging", "tracing", "recovery", "auth", "authorize:password:write", "rateLimit:sign_up", "validation:ChangePassword", "dbTrx", "handler"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInComposingOnlyTheMiddlewaresTheRouteRequires",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{
+						Name:            "GetLiveness",
+						Method:          http.MethodGet,
+						Path:            "/livez",
+						HandlerFunc:     handler,
+						RateLimitPolicy: "disabled",
+						Instrumentation: routehttputilpkg.InstrumentationMetrics,
+					},
+				}
+				middlewares = apirouter.Middlewares{
+					Metrics:    record("metrics"),
+					RateLimits: map[string]adapterhttputilpkg.Adapter{"disabled": nil},
+				}
+				chain = []string{"metrics", "handler"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheRoutesAreMisconfigured",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{Name: "SignOut", Method: http.MethodPost, Path: "/sign_out", HandlerFunc: handler, Auth: routehttputilpkg.AuthToken},
+					{Name: "RefreshToken", Method: http.MethodPost, Path: "/refresh_token", HandlerFunc: handler, Auth: routehttputilpkg.AuthTokenRenewal},
+					{Name: "SignIn", Method: http.MethodPost, Path: "/sign_in", HandlerFunc: handler, RateLimitPolicy: "sign_inn"},
+					{Name: "GetAllUsers", Method: http.MethodGet, Path: "/users", HandlerFunc: handler, Permissions: []string{"users:read"}},
+					{Name: "SignUp", Method: http.MethodPost, Path: "/sign_out", HandlerFunc: handler},
+				}
+				middlewares = apirouter.Middlewares{
+					Metrics:    record("metrics"),
+					Logging:    record("logging"),
+					Tracing:    record("tracing"),
+					Auth:       record("auth"),
+					RateLimits: map[string]adapterhttputilpkg.Adapter{},
+				}
+				errorMessages = []string{
+					"the route RefreshToken requires the auth renewal middleware, which is not configured",
+					"the route SignIn has an unknown rate limit policy: sign_inn",
+					"the route GetAllUsers requires permissions but no authentication",
+					"the route SignUp has the same method and path as the route SignOut: POST /sign_out",
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRoutes, err := apirouter.Build(routes, middlewares)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, len(routes), len(returnedRoutes))
+
+				calls = make([]string, 0)
+
+				req := httptest.NewRequest(routes[0].Method, routes[0].Path, nil)
+				returnedRoutes[0].HandlerFunc.ServeHTTP(httptest.NewRecorder(), req)
+
+				assert.Equal(t, chain, calls)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				for _, errorMessage := range errorMessages {
+					assert.Contains(t, err.Error(), errorMessage)
+				}
+			}
+		})
+	}
+}
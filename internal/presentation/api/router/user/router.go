@@ -1,20 +1,28 @@
 package user
 
 import (
+	"net/http"
+
 	userhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/user"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
 )
 
 // ConfigureRoutes is the function that arranges the user's routes.
-func ConfigureRoutes(userHandler userhandler.IHandler, adapters map[string]adapterhttputilpkg.Adapter) routehttputilpkg.Routes {
+func ConfigureRoutes(userHandler userhandler.IHandler) routehttputilpkg.Routes {
 	return routehttputilpkg.Routes{
 		routehttputilpkg.Route{
-			Name:   "GetAllUsers",
-			Method: "GET",
-			Path:   "/users",
-			HandlerFunc: adapterhttputilpkg.AdaptFunc(userHandler.GetAll).
-				With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+			Name:        "GetAllUsers",
+			Method:      http.MethodGet,
+			Version:     routehttputilpkg.V1,
+			Path:        "/users",
+			HandlerFunc: userHandler.GetAll,
+			Auth:        routehttputilpkg.AuthToken,
+			Doc: &routehttputilpkg.Doc{
+				Summary:   "API endpoint to get the list of all users.",
+				Tags:      []string{"user"},
+				Responses: map[int]interface{}{http.StatusOK: presentableentity.Users{}},
+			},
 		},
 	}
 }
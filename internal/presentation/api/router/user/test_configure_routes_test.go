@@ -1,47 +1,42 @@
 package user_test
 
 import (
+	"net/http"
 	"reflect"
 	"runtime"
 	"testing"
 
 	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
 	userhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/user"
+	presentableentity "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/presentity"
 	userrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/user"
-	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
-	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
-	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
-	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/gorm"
 )
 
 func (ts *TestSuite) TestConfigureRoutes() {
 	routes := routehttputilpkg.Routes{}
 
-	db := &gorm.DB{}
-	authN := authpkg.New(authpkg.RSAKeys{})
-
 	userService := new(usermockservice.Service)
 	userHandler := userhandler.New(userService)
 
-	adapters := map[string]adapterhttputilpkg.Adapter{
-		"loggingMiddleware": loggingmiddlewarepkg.Logging(),
-		"authMiddleware":    authmiddlewarepkg.Auth(db, authN),
-	}
-
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInConfiguringTheRoutes",
 			SetUp: func(t *testing.T) {
 				routes = routehttputilpkg.Routes{
 					routehttputilpkg.Route{
-						Name:   "GetAllUsers",
-						Method: "GET",
-						Path:   "/users",
-						HandlerFunc: adapterhttputilpkg.AdaptFunc(userHandler.GetAll).
-							With(adapters["loggingMiddleware"], adapters["authMiddleware"]),
+						Name:        "GetAllUsers",
+						Method:      http.MethodGet,
+						Version:     routehttputilpkg.V1,
+						Path:        "/users",
+						HandlerFunc: userHandler.GetAll,
+						Auth:        routehttputilpkg.AuthToken,
+						Doc: &routehttputilpkg.Doc{
+							Summary:   "API endpoint to get the list of all users.",
+							Tags:      []string{"user"},
+							Responses: map[int]interface{}{http.StatusOK: presentableentity.Users{}},
+						},
 					},
 				}
 			},
@@ -52,17 +47,17 @@ func (ts *TestSuite) TestConfigureRoutes() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			returnedRoutes := userrouter.ConfigureRoutes(userHandler, adapters)
+			returnedRoutes := userrouter.ConfigureRoutes(userHandler)
 
 			assert.Equal(t, len(routes), len(returnedRoutes))
 
 			for i := range routes {
-				assert.Equal(t, routes[i].Name, returnedRoutes[i].Name)
-				assert.Equal(t, routes[i].Method, returnedRoutes[i].Method)
-				assert.Equal(t, routes[i].Path, returnedRoutes[i].Path)
 				handlerFunc1 := runtime.FuncForPC(reflect.ValueOf(routes[i].HandlerFunc).Pointer()).Name()
 				handlerFunc2 := runtime.FuncForPC(reflect.ValueOf(returnedRoutes[i].HandlerFunc).Pointer()).Name()
 				assert.Equal(t, handlerFunc1, handlerFunc2)
+				routes[i].HandlerFunc = nil
+				returnedRoutes[i].HandlerFunc = nil
+				assert.Equal(t, routes[i], returnedRoutes[i])
 			}
 		})
 	}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+
+	authv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/auth/v1"
+	authservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/auth"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+)
+
+type Handler struct {
+	authv1.UnimplementedAuthServiceServer
+	AuthService authservice.IService
+}
+
+// New is the factory function that encapsulates the implementation related to auth gRPC handler.
+func New(authService authservice.IService) authv1.AuthServiceServer {
+	return &Handler{
+		AuthService: authService,
+	}
+}
+
+// SignUp is the function that performs sign up.
+func (h *Handler) SignUp(ctx context.Context, req *authv1.SignUpRequest) (*authv1.SignUpResponse, error) {
+	dbTrx, ok := dbtrxmiddlewarepkg.FromContext(ctx)
+	if !ok || dbTrx == nil {
+		return nil, statusgrpcutilpkg.Error(ctx, customerror.New("failed to get the db_trx value from the request context"))
+	}
+
+	credentials := securitypkg.Credentials{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+	}
+
+	token, err := h.AuthService.WithDBTrx(dbTrx).Register(ctx, credentials)
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	return &authv1.SignUpResponse{Token: token}, nil
+}
+
+// SignIn is the function that performs sign in.
+func (h *Handler) SignIn(ctx context.Context, req *authv1.SignInRequest) (*authv1.SignInResponse, error) {
+	dbTrx, ok := dbtrxmiddlewarepkg.FromContext(ctx)
+	if !ok || dbTrx == nil {
+		return nil, statusgrpcutilpkg.Error(ctx, customerror.New("failed to get the db_trx value from the request context"))
+	}
+
+	credentials := securitypkg.Credentials{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+	}
+
+	token, err := h.AuthService.WithDBTrx(dbTrx).LogIn(ctx, credentials)
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	return &authv1.SignInResponse{Token: token}, nil
+}
+
+// RefreshToken is the function that refreshes the access token.
+func (h *Handler) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.RefreshTokenResponse, error) {
+	auth, ok := authmiddlewarepkg.FromContext(ctx)
+	if !ok || auth.IsEmpty() {
+		return nil, statusgrpcutilpkg.Error(ctx, customerror.New("failed to get the auth_details value from the request context"))
+	}
+
+	token, err := h.AuthService.WithDBTrx(nil).RenewToken(ctx, auth)
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	return &authv1.RefreshTokenResponse{Token: token}, nil
+}
+
+// ChangePassword is the function that changes the user's password.
+func (h *Handler) ChangePassword(ctx context.Context, req *authv1.ChangePasswordRequest) (*authv1.ChangePasswordResponse, error) {
+	auth, ok := authmiddlewarepkg.FromContext(ctx)
+	if !ok || auth.IsEmpty() {
+		return nil, statusgrpcutilpkg.Error(ctx, customerror.New("failed to get the auth_details value from the request context"))
+	}
+
+	passwords := securitypkg.Passwords{
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+	}
+
+	var versions []int
+	if req.GetVersion() != 0 {
+		versions = []int{int(req.GetVersion())}
+	}
+
+	version, err := h.AuthService.WithDBTrx(nil).ModifyPassword(ctx, auth.UserID.String(), passwords, versions)
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	return &authv1.ChangePasswordResponse{Message: "the password has been updated successfully", Version: int32(version)}, nil
+}
+
+// SignOut is the function that performs sign out.
+func (h *Handler) SignOut(ctx context.Context, req *authv1.SignOutRequest) (*authv1.SignOutResponse, error) {
+	auth, ok := authmiddlewarepkg.FromContext(ctx)
+	if !ok || auth.IsEmpty() {
+		return nil, statusgrpcutilpkg.Error(ctx, customerror.New("failed to get the auth_details value from the request context"))
+	}
+
+	err := h.AuthService.WithDBTrx(nil).LogOut(ctx, auth.ID.String())
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	return &authv1.SignOutResponse{Message: "you have logged out successfully"}, nil
+}
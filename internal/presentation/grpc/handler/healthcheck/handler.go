@@ -0,0 +1,95 @@
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	healthcheckservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/healthcheck"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type Handler struct {
+	healthv1.UnimplementedHealthServer
+	HealthCheckService healthcheckservice.IService
+	Services           []string
+	WatchInterval      time.Duration
+}
+
+// New is the factory function that encapsulates the implementation related to the gRPC health checking protocol.
+// The health of the server, named by the empty service, and of each of the given services is the health of the application,
+// since they share its dependencies. The watched health is checked again at the given interval.
+func New(healthCheckService healthcheckservice.IService, services []string, watchInterval time.Duration) healthv1.HealthServer {
+	return &Handler{
+		HealthCheckService: healthCheckService,
+		Services:           services,
+		WatchInterval:      watchInterval,
+	}
+}
+
+// isServed is the function that checks if a service is served.
+func (h *Handler) isServed(service string) bool {
+	if service == "" {
+		return true
+	}
+
+	for _, name := range h.Services {
+		if name == service {
+			return true
+		}
+	}
+
+	return false
+}
+
+// servingStatus is the function that gets the serving status of a service from the health of the application.
+func (h *Handler) servingStatus(ctx context.Context, service string) healthv1.HealthCheckResponse_ServingStatus {
+	if !h.isServed(service) {
+		return healthv1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
+	if !h.HealthCheckService.GetHealth(ctx).IsHealthy() {
+		return healthv1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthv1.HealthCheckResponse_SERVING
+}
+
+// Check is the function that checks the health of a service.
+func (h *Handler) Check(ctx context.Context, req *healthv1.HealthCheckRequest) (*healthv1.HealthCheckResponse, error) {
+	if !h.isServed(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "the service %s is unknown", req.GetService())
+	}
+
+	return &healthv1.HealthCheckResponse{Status: h.servingStatus(ctx, req.GetService())}, nil
+}
+
+// Watch is the function that sends the health of a service at first and then whenever it changes,
+// until the client cancels the call.
+func (h *Handler) Watch(req *healthv1.HealthCheckRequest, stream healthv1.Health_WatchServer) error {
+	ctx := stream.Context()
+
+	ticker := time.NewTicker(h.WatchInterval)
+	defer ticker.Stop()
+
+	lastStatus := healthv1.HealthCheckResponse_ServingStatus(-1)
+
+	for {
+		servingStatus := h.servingStatus(ctx, req.GetService())
+
+		if servingStatus != lastStatus {
+			if err := stream.Send(&healthv1.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+
+			lastStatus = servingStatus
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	healthcheckmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/healthcheck"
+	grpchealthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/healthcheck"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func (ts *TestSuite) TestCheck() {
+	services := []string{"auth.v1.AuthService"}
+
+	service := ""
+
+	report := healthpkg.Report{}
+
+	servingStatus := healthv1.HealthCheckResponse_UNKNOWN
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCheckingTheHealthOfTheServer",
+			SetUp: func(t *testing.T) {
+				service = ""
+				report = healthpkg.Report{Status: healthpkg.StatusPass}
+				servingStatus = healthv1.HealthCheckResponse_SERVING
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCheckingTheHealthOfAServiceThatIsNotServing",
+			SetUp: func(t *testing.T) {
+				service = "auth.v1.AuthService"
+				report = healthpkg.Report{Status: healthpkg.StatusFail}
+				servingStatus = healthv1.HealthCheckResponse_NOT_SERVING
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheServiceIsUnknown",
+			SetUp: func(t *testing.T) {
+				service = "unknown.v1.UnknownService"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			healthCheckService := new(healthcheckmockservice.Service)
+			healthCheckService.On("GetHealth", mock.Anything).Return(report)
+
+			handler := grpchealthcheckhandler.New(healthCheckService, services, time.Second)
+
+			resp, err := handler.Check(context.Background(), &healthv1.HealthCheckRequest{Service: service})
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, servingStatus, resp.GetStatus())
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, codes.NotFound, status.Code(err))
+				healthCheckService.AssertNotCalled(t, "GetHealth", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	healthcheckmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/healthcheck"
+	grpchealthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/healthcheck"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// watchServer is the stream of a watch that records the statuses sent and is cancelled once it gets the expected number of them.
+type watchServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	statuses []healthv1.HealthCheckResponse_ServingStatus
+	expected int
+}
+
+func (s *watchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchServer) Send(resp *healthv1.HealthCheckResponse) error {
+	s.statuses = append(s.statuses, resp.GetStatus())
+
+	if len(s.statuses) == s.expected {
+		s.cancel()
+	}
+
+	return nil
+}
+
+func (ts *TestSuite) TestWatch() {
+	services := []string{"auth.v1.AuthService"}
+
+	service := ""
+
+	reports := []healthpkg.Report{}
+
+	statuses := []healthv1.HealthCheckResponse_ServingStatus{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSendingTheHealthOfAServiceWheneverItChanges",
+			SetUp: func(t *testing.T) {
+				service = "auth.v1.AuthService"
+				reports = []healthpkg.Report{
+					{Status: healthpkg.StatusPass},
+					{Status: healthpkg.StatusPass},
+					{Status: healthpkg.StatusFail},
+				}
+				statuses = []healthv1.HealthCheckResponse_ServingStatus{
+					healthv1.HealthCheckResponse_SERVING,
+					healthv1.HealthCheckResponse_NOT_SERVING,
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSendingThatTheServiceIsUnknown",
+			SetUp: func(t *testing.T) {
+				service = "unknown.v1.UnknownService"
+				reports = []healthpkg.Report{}
+				statuses = []healthv1.HealthCheckResponse_ServingStatus{
+					healthv1.HealthCheckResponse_SERVICE_UNKNOWN,
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			healthCheckService := new(healthcheckmockservice.Service)
+			for _, report := range reports {
+				healthCheckService.On("GetHealth", mock.Anything).Return(report).Once()
+			}
+
+			handler := grpchealthcheckhandler.New(healthCheckService, services, time.Millisecond)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			stream := &watchServer{ctx: ctx, cancel: cancel, expected: len(statuses)}
+
+			err := handler.Watch(&healthv1.HealthCheckRequest{Service: service}, stream)
+
+			assert.Equal(t, codes.Canceled, status.Code(err))
+			assert.Equal(t, statuses, stream.statuses)
+			healthCheckService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	userv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/user/v1"
+	userservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/service/user"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+)
+
+type Handler struct {
+	userv1.UnimplementedUserServiceServer
+	UserService userservice.IService
+}
+
+// New is the factory function that encapsulates the implementation related to user gRPC handler.
+func New(userService userservice.IService) userv1.UserServiceServer {
+	return &Handler{
+		UserService: userService,
+	}
+}
+
+// GetAllUsers is the function that gets the list of all users.
+func (h *Handler) GetAllUsers(ctx context.Context, req *userv1.GetAllUsersRequest) (*userv1.GetAllUsersResponse, error) {
+	domainUsers, err := h.UserService.WithDBTrx(nil).GetAll(ctx)
+	if err != nil {
+		return nil, statusgrpcutilpkg.Error(ctx, err)
+	}
+
+	resp := &userv1.GetAllUsersResponse{
+		Users: make([]*userv1.User, 0, len(domainUsers)),
+	}
+
+	for _, domainUser := range domainUsers {
+		resp.Users = append(resp.Users, &userv1.User{
+			Id:       domainUser.ID.String(),
+			Username: domainUser.Username,
+		})
+	}
+
+	return resp, nil
+}
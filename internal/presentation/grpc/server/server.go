@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	authv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/auth/v1"
+	userv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/user/v1"
+	authrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/auth"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"google.golang.org/grpc"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Methods are the methods served along with their requirements, from which the interceptors decide what to apply to their calls.
+// They match the requirements and the rate limit policies of the routes of the same operations of the API. The unary calls of the methods that are
+// not declared are rejected, while the streams of the health checking and the reflection services are not intercepted.
+var Methods = methodgrpcutilpkg.Methods{
+	{FullMethod: healthv1.Health_Check_FullMethodName},
+	{FullMethod: authv1.AuthService_SignUp_FullMethodName, Transaction: routehttputilpkg.TransactionReadWrite, RateLimitPolicy: authrouter.RateLimitPolicySignUp},
+	{FullMethod: authv1.AuthService_SignIn_FullMethodName, Transaction: routehttputilpkg.TransactionReadWrite, RateLimitPolicy: authrouter.RateLimitPolicySignIn},
+	{FullMethod: authv1.AuthService_RefreshToken_FullMethodName, Auth: routehttputilpkg.AuthTokenRenewal},
+	{FullMethod: authv1.AuthService_ChangePassword_FullMethodName, Auth: routehttputilpkg.AuthToken, RateLimitPolicy: authrouter.RateLimitPolicyChangePassword},
+	{FullMethod: authv1.AuthService_SignOut_FullMethodName, Auth: routehttputilpkg.AuthToken},
+	{FullMethod: userv1.UserService_GetAllUsers_FullMethodName, Auth: routehttputilpkg.AuthToken},
+}
+
+// ServiceNames are the names of the services served, whose health is reported by the health checking protocol.
+var ServiceNames = []string{
+	authv1.AuthService_ServiceDesc.ServiceName,
+	userv1.UserService_ServiceDesc.ServiceName,
+}
+
+// Handlers are the handlers of the services served.
+type Handlers struct {
+	Auth        authv1.AuthServiceServer
+	User        userv1.UserServiceServer
+	HealthCheck healthv1.HealthServer
+}
+
+type Server struct {
+	Instance   *grpc.Server
+	tcpAddress string
+}
+
+// New is the factory function that encapsulates the implementation related to gRPC server.
+// The reflection service is registered along with the handlers, so that tools such as grpcurl can discover the services.
+// It fails if a method of the auth's or the user's services is not declared in Methods, so that the application does not start.
+func New(tcpAddress string, handlers Handlers, options ...grpc.ServerOption) (*Server, error) {
+	undeclared := Methods.Undeclared(&authv1.AuthService_ServiceDesc, &userv1.UserService_ServiceDesc)
+	if len(undeclared) > 0 {
+		return nil, fmt.Errorf("the methods %s are not declared", strings.Join(undeclared, ", "))
+	}
+
+	instance := grpc.NewServer(options...)
+
+	authv1.RegisterAuthServiceServer(instance, handlers.Auth)
+	userv1.RegisterUserServiceServer(instance, handlers.User)
+	healthv1.RegisterHealthServer(instance, handlers.HealthCheck)
+	reflection.Register(instance)
+
+	return &Server{
+		Instance:   instance,
+		tcpAddress: tcpAddress,
+	}, nil
+}
+
+// Address is the function that returns the address the server listens on.
+func (s *Server) Address() string {
+	return s.tcpAddress
+}
+
+// Start is the function that starts the server.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.tcpAddress)
+	if err != nil {
+		return err
+	}
+
+	return s.Instance.Serve(listener)
+}
+
+// Stop is the function that stops the server, waiting for the ongoing calls to finish until the context is done,
+// when they are cancelled.
+func (s *Server) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		s.Instance.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Instance.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,278 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	fake "github.com/brianvoe/gofakeit/v5"
+	authv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/auth/v1"
+	userv1 "github.com/icaroribeiro/go-code-challenge-template/api/proto/user/v1"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	healthcheckmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/healthcheck"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	grpcauthhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/auth"
+	grpchealthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/healthcheck"
+	grpcuserhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/user"
+	grpcserver "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/server"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	authinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/auth"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (ts *TestSuite) TestNew() {
+	authService := new(authmockservice.Service)
+	userService := new(usermockservice.Service)
+	healthCheckService := new(healthcheckmockservice.Service)
+
+	auth := domainentity.AuthFactory(map[string]interface{}{"id": uuid.NewV4(), "userID": uuid.NewV4()})
+
+	tokenString := fake.Word()
+
+	// call is the function that performs the call whose response is checked.
+	var call func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error)
+
+	var response proto.Message
+
+	code := codes.OK
+
+	reason := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSigningUp",
+			SetUp: func(t *testing.T) {
+				credentials := securitypkg.Credentials{Username: fake.Username(), Password: fake.Password(true, true, true, false, false, 8)}
+
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("Register", mock.Anything, credentials).Return(tokenString, nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					return authv1.NewAuthServiceClient(conn).SignUp(ctx, &authv1.SignUpRequest{Username: credentials.Username, Password: credentials.Password})
+				}
+
+				response = &authv1.SignUpResponse{Token: tokenString}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSigningIn",
+			SetUp: func(t *testing.T) {
+				credentials := securitypkg.Credentials{Username: fake.Username(), Password: fake.Password(true, true, true, false, false, 8)}
+
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("LogIn", mock.Anything, credentials).Return(tokenString, nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					return authv1.NewAuthServiceClient(conn).SignIn(ctx, &authv1.SignInRequest{Username: credentials.Username, Password: credentials.Password})
+				}
+
+				response = &authv1.SignInResponse{Token: tokenString}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRefreshingTheToken",
+			SetUp: func(t *testing.T) {
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("RenewToken", mock.Anything, auth).Return(tokenString, nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					ctx = metadata.AppendToOutgoingContext(ctx, authinterceptorpkg.MetadataKey, "Bearer "+tokenString)
+					return authv1.NewAuthServiceClient(conn).RefreshToken(ctx, &authv1.RefreshTokenRequest{})
+				}
+
+				response = &authv1.RefreshTokenResponse{Token: tokenString}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInChangingThePassword",
+			SetUp: func(t *testing.T) {
+				passwords := securitypkg.Passwords{CurrentPassword: "current", NewPassword: "new"}
+
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("ModifyPassword", mock.Anything, auth.UserID.String(), passwords, []int{2}).Return(3, nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					ctx = metadata.AppendToOutgoingContext(ctx, authinterceptorpkg.MetadataKey, "Bearer "+tokenString)
+					return authv1.NewAuthServiceClient(conn).ChangePassword(ctx, &authv1.ChangePasswordRequest{
+						CurrentPassword: passwords.CurrentPassword,
+						NewPassword:     passwords.NewPassword,
+						Version:         2,
+					})
+				}
+
+				response = &authv1.ChangePasswordResponse{Message: "the password has been updated successfully", Version: 3}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSigningOut",
+			SetUp: func(t *testing.T) {
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("LogOut", mock.Anything, auth.ID.String()).Return(nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					ctx = metadata.AppendToOutgoingContext(ctx, authinterceptorpkg.MetadataKey, "Bearer "+tokenString)
+					return authv1.NewAuthServiceClient(conn).SignOut(ctx, &authv1.SignOutRequest{})
+				}
+
+				response = &authv1.SignOutResponse{Message: "you have logged out successfully"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingAllUsers",
+			SetUp: func(t *testing.T) {
+				user := domainentity.UserFactory(nil)
+
+				userService = new(usermockservice.Service)
+				userService.On("WithDBTrx", mock.Anything).Return(userService)
+				userService.On("GetAll", mock.Anything).Return(domainentity.Users{user}, nil)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					ctx = metadata.AppendToOutgoingContext(ctx, authinterceptorpkg.MetadataKey, "Bearer "+tokenString)
+					return userv1.NewUserServiceClient(conn).GetAllUsers(ctx, &userv1.GetAllUsersRequest{})
+				}
+
+				response = &userv1.GetAllUsersResponse{Users: []*userv1.User{{Id: user.ID.String(), Username: user.Username}}}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCheckingTheHealthOfAServiceServed",
+			SetUp: func(t *testing.T) {
+				healthCheckService = new(healthcheckmockservice.Service)
+				healthCheckService.On("GetHealth", mock.Anything).Return(healthpkg.Report{Status: healthpkg.StatusPass})
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					return healthv1.NewHealthClient(conn).Check(ctx, &healthv1.HealthCheckRequest{Service: authv1.AuthService_ServiceDesc.ServiceName})
+				}
+
+				response = &healthv1.HealthCheckResponse{Status: healthv1.HealthCheckResponse_SERVING}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInListingTheServicesServedThroughTheReflection",
+			SetUp: func(t *testing.T) {
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					stream, err := reflectionv1alpha.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					err = stream.Send(&reflectionv1alpha.ServerReflectionRequest{
+						MessageRequest: &reflectionv1alpha.ServerReflectionRequest_ListServices{},
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					resp, err := stream.Recv()
+					if err != nil {
+						return nil, err
+					}
+
+					return resp.GetListServicesResponse(), nil
+				}
+
+				response = &reflectionv1alpha.ListServiceResponse{
+					Service: []*reflectionv1alpha.ServiceResponse{
+						{Name: "auth.v1.AuthService"},
+						{Name: "grpc.health.v1.Health"},
+						{Name: "grpc.reflection.v1.ServerReflection"},
+						{Name: "grpc.reflection.v1alpha.ServerReflection"},
+						{Name: "user.v1.UserService"},
+					},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheServiceFails",
+			SetUp: func(t *testing.T) {
+				authService = new(authmockservice.Service)
+				authService.On("WithDBTrx", mock.Anything).Return(authService)
+				authService.On("Register", mock.Anything, mock.Anything).
+					Return("", customerror.WithCode(customerror.Conflict.New("the username is already registered"), "USERNAME_TAKEN"))
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					return authv1.NewAuthServiceClient(conn).SignUp(ctx, &authv1.SignUpRequest{Username: fake.Username(), Password: fake.Word()})
+				}
+
+				code = codes.AlreadyExists
+				reason = "USERNAME_TAKEN"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheTokenOfAMethodThatRequiresItIsNotInformed",
+			SetUp: func(t *testing.T) {
+				userService = new(usermockservice.Service)
+
+				call = func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+					return userv1.NewUserServiceClient(conn).GetAllUsers(ctx, &userv1.GetAllUsersRequest{})
+				}
+
+				code = codes.Unauthenticated
+				reason = "UNAUTHORIZED"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			conn, closeConn := ts.NewClientConn(grpcserver.Handlers{
+				Auth:        grpcauthhandler.New(authService),
+				User:        grpcuserhandler.New(userService),
+				HealthCheck: grpchealthcheckhandler.New(healthCheckService, grpcserver.ServiceNames, time.Second),
+			}, auth)
+			defer closeConn()
+
+			returnedResponse, err := call(context.Background(), conn)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.True(t, proto.Equal(response, returnedResponse), fmt.Sprintf("Unexpected response: %v", returnedResponse))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, code, status.Code(err))
+				details := status.Convert(err).Details()
+				assert.NotEmpty(t, details)
+				if len(details) > 0 {
+					errorInfo, ok := details[0].(*errdetails.ErrorInfo)
+					assert.True(t, ok)
+					assert.Equal(t, reason, errorInfo.GetReason())
+				}
+			}
+
+			authService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			healthCheckService.AssertExpectations(t)
+		})
+	}
+}
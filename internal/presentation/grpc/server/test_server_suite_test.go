@@ -0,0 +1,106 @@
+package server_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	grpcserver "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/server"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	authinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/auth"
+	recoveryinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/recovery"
+	requestidinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/requestid"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+type Case struct {
+	Context   string
+	SetUp     func(t *testing.T)
+	WantError bool
+	TearDown  func(t *testing.T)
+}
+
+type Cases []Case
+
+type TestSuite struct {
+	suite.Suite
+	Cases Cases
+}
+
+// NewClientConn is the function that serves the given handlers in memory and connects to them.
+// The interceptors that depend on the database are replaced by ones that do not, and the auth details stored in context
+// of the calls of the methods that require a token are the given ones.
+func (ts *TestSuite) NewClientConn(handlers grpcserver.Handlers, auth domainentity.Auth) (*grpc.ClientConn, func()) {
+	authInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := grpcserver.Methods.Find(info.FullMethod)
+		if !ok || method.Auth == routehttputilpkg.AuthNone {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get(authinterceptorpkg.MetadataKey)) == 0 {
+			return nil, statusgrpcutilpkg.Error(ctx, customerror.Unauthorized.New("the token must be informed"))
+		}
+
+		return handler(authmiddlewarepkg.NewContext(ctx, auth), req)
+	}
+
+	dbTrxInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := grpcserver.Methods.Find(info.FullMethod)
+		if !ok || method.Transaction != routehttputilpkg.TransactionReadWrite {
+			return handler(ctx, req)
+		}
+
+		return handler(dbtrxmiddlewarepkg.NewContext(ctx, &gorm.DB{}), req)
+	}
+
+	server, err := grpcserver.New("bufconn", handlers,
+		grpc.ChainUnaryInterceptor(
+			requestidinterceptorpkg.RequestID(),
+			recoveryinterceptorpkg.Recovery(),
+			authInterceptor,
+			dbTrxInterceptor,
+		),
+	)
+	if err != nil {
+		log.Panicf("%s", err.Error())
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	go func() {
+		if err := server.Instance.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			log.Panicf("failed to serve: %s", err.Error())
+		}
+	}()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Panicf("failed to dial: %s", err.Error())
+	}
+
+	return conn, func() {
+		conn.Close()
+		server.Instance.Stop()
+	}
+}
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
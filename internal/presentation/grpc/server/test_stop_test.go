@@ -0,0 +1,60 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	healthcheckmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/healthcheck"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	grpcauthhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/auth"
+	grpchealthcheckhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/healthcheck"
+	grpcuserhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/handler/user"
+	grpcserver "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/grpc/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func (ts *TestSuite) TestStop() {
+	handlers := grpcserver.Handlers{
+		Auth:        grpcauthhandler.New(new(authmockservice.Service)),
+		User:        grpcuserhandler.New(new(usermockservice.Service)),
+		HealthCheck: grpchealthcheckhandler.New(new(healthcheckmockservice.Service), grpcserver.ServiceNames, time.Second),
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInStoppingTheServer",
+			SetUp:   func(t *testing.T) {},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			server, err := grpcserver.New("127.0.0.1:0", handlers)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			errChan := make(chan error, 1)
+
+			go func() {
+				errChan <- server.Start()
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			err = server.Stop(ctx)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			// The server may be stopped before it starts serving, in which case it refuses to start.
+			err = <-errChan
+			if err != nil {
+				assert.Equal(t, grpc.ErrServerStopped, err)
+			}
+		})
+	}
+}
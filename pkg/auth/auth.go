@@ -11,6 +11,17 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+const (
+	// CodeTokenMissing is the code of the error returned when the auth header does not inform a token.
+	CodeTokenMissing = "TOKEN_MISSING"
+	// CodeTokenExpired is the code of the error returned when the token has expired.
+	CodeTokenExpired = "TOKEN_EXPIRED"
+	// CodeTokenInvalid is the code of the error returned when the token cannot be decoded.
+	CodeTokenInvalid = "TOKEN_INVALID"
+	// CodeTokenRenewalTooEarly is the code of the error returned when the token is renewed too long before it expires.
+	CodeTokenRenewalTooEarly = "TOKEN_RENEWAL_TOO_EARLY"
+)
+
 type Auth struct {
 	RSAKeys RSAKeys
 }
@@ -62,13 +73,13 @@ func parseToken(tokenString string, publicKey *rsa.PublicKey) (*jwt.Token, error
 func (a *Auth) ExtractTokenString(authHeaderString string) (string, error) {
 	if len(authHeaderString) == 0 {
 		errorMessage := "the auth header must be informed along with the token"
-		return "", customerror.BadRequest.New(errorMessage)
+		return "", customerror.WithCode(customerror.BadRequest.New(errorMessage), CodeTokenMissing)
 	}
 
 	bearerToken := strings.Split(authHeaderString, " ")
 	if bearerToken[1] == "" {
 		errorMessage := "the token must be associated with the auth header"
-		return "", customerror.BadRequest.New(errorMessage)
+		return "", customerror.WithCode(customerror.BadRequest.New(errorMessage), CodeTokenMissing)
 	}
 
 	return bearerToken[1], nil
@@ -83,9 +94,9 @@ func (a *Auth) DecodeToken(tokenString string) (*jwt.Token, error) {
 		switch verr.Errors {
 		case jwt.ValidationErrorExpired:
 			errorMessage := "the token has expired"
-			return nil, customerror.Unauthorized.New(errorMessage)
+			return nil, customerror.WithCode(customerror.Unauthorized.New(errorMessage), CodeTokenExpired)
 		default:
-			return nil, err
+			return nil, customerror.WithCode(err, CodeTokenInvalid)
 		}
 	}
 
@@ -102,7 +113,7 @@ func (a *Auth) ValidateTokenRenewal(token *jwt.Token, timeBeforeTokenExpTimeInSe
 
 	if time.Until(time.Unix(int64(expiredAt), 0)) > duration {
 		errorMessage := "the token expiration time is not within the time prior to the time before token expiration time"
-		return token, customerror.BadRequest.New(errorMessage)
+		return token, customerror.WithCode(customerror.BadRequest.New(errorMessage), CodeTokenRenewalTooEarly)
 	}
 
 	return token, nil
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+)
+
+// NewKeysChecker is the function that builds the checker of the RSA keys used to sign and verify the tokens.
+func NewKeysChecker(rsaKeys RSAKeys) healthpkg.Checker {
+	return healthpkg.NewChecker("signing_keys", func(ctx context.Context) error {
+		if rsaKeys.PrivateKey == nil || rsaKeys.PublicKey == nil {
+			return errors.New("the signing keys are not loaded")
+		}
+
+		if err := rsaKeys.PrivateKey.Validate(); err != nil {
+			return err
+		}
+
+		if !rsaKeys.PublicKey.Equal(&rsaKeys.PrivateKey.PublicKey) {
+			return errors.New("the public key does not match the private key")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"testing"
+
+	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNewKeysChecker() {
+	rsaKeys := authpkg.RSAKeys{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCheckingTheSigningKeys",
+			SetUp: func(t *testing.T) {
+				rsaKeys = ts.RSAKeys
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheSigningKeysAreNotLoaded",
+			SetUp: func(t *testing.T) {
+				rsaKeys = authpkg.RSAKeys{}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfThePublicKeyDoesNotMatchThePrivateKey",
+			SetUp: func(t *testing.T) {
+				otherPrivateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				rsaKeys = authpkg.RSAKeys{
+					PublicKey:  &otherPrivateKey.PublicKey,
+					PrivateKey: ts.RSAKeys.PrivateKey,
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			checker := authpkg.NewKeysChecker(rsaKeys)
+
+			err := checker.Check(context.Background())
+
+			assert.Equal(t, "signing_keys", checker.Name())
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	etaghttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/etag"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Login is the login's model returned by the API.
+type Login struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// SignUp is the function that registers a user and signs the client in with the returned token.
+func (c *Client) SignUp(ctx context.Context, credentials securitypkg.Credentials) (string, error) {
+	return c.signIn(ctx, "/sign_up", credentials)
+}
+
+// SignIn is the function that logs a user in and signs the client in with the returned token.
+func (c *Client) SignIn(ctx context.Context, credentials securitypkg.Credentials) (string, error) {
+	return c.signIn(ctx, "/sign_in", credentials)
+}
+
+// signIn is the function that keeps the token returned by a call that logs a user in.
+func (c *Client) signIn(ctx context.Context, path string, credentials securitypkg.Credentials) (string, error) {
+	token := tokenhttputilpkg.Token{}
+
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: path, body: credentials}, false, &token); err != nil {
+		return "", err
+	}
+
+	c.SetToken(token.Text)
+
+	return token.Text, nil
+}
+
+// RefreshToken is the function that renews the token of the signed in user, which the client keeps.
+func (c *Client) RefreshToken(ctx context.Context) (string, error) {
+	token := tokenhttputilpkg.Token{Text: c.Token()}
+	if token.Text == "" {
+		return "", ErrNotSignedIn
+	}
+
+	if _, err := c.send(ctx, request{method: http.MethodPost, path: "/refresh_token", token: token.Text}, &token); err != nil {
+		return "", err
+	}
+
+	c.SetToken(token.Text)
+
+	return token.Text, nil
+}
+
+// GetLogin is the function that gets the login of the signed in user along with its version,
+// which the password changes may expect.
+func (c *Client) GetLogin(ctx context.Context) (Login, int, error) {
+	login := Login{}
+
+	header, err := c.do(ctx, request{method: http.MethodGet, path: "/login"}, true, &login)
+	if err != nil {
+		return Login{}, 0, err
+	}
+
+	return login, etaghttputilpkg.Parse(header.Get("ETag")), nil
+}
+
+// ChangePassword is the function that resets the password of the signed in user and returns the version of its login.
+// If the version is informed, the password is only reset if the login was not modified since that version,
+// otherwise the call fails with a precondition failed error.
+func (c *Client) ChangePassword(ctx context.Context, passwords securitypkg.Passwords, version int) (int, error) {
+	req := request{method: http.MethodPost, path: "/change_password", body: passwords}
+
+	if version > 0 {
+		req.headers = map[string]string{"If-Match": etaghttputilpkg.Format(version)}
+	}
+
+	header, err := c.do(ctx, req, true, &responsehttputilpkg.Message{})
+	if err != nil {
+		return 0, err
+	}
+
+	return etaghttputilpkg.Parse(header.Get("ETag")), nil
+}
+
+// SignOut is the function that logs the signed in user out and forgets its token.
+func (c *Client) SignOut(ctx context.Context) error {
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: "/sign_out"}, true, &responsehttputilpkg.Message{}); err != nil {
+		return err
+	}
+
+	c.SetToken("")
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+)
+
+// RetryPolicy is the model of the retries of the idempotent calls that fail with a transient error.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a call is made, including the first one. The calls are not retried if it is one or less.
+	MaxAttempts int
+	// InitialBackoff is the time waited before the first retry, which doubles at each retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the longest time waited before a retry, including the one informed by the Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// Options is the set of settings of the client.
+type Options struct {
+	// HTTPClient is the client the calls are made with.
+	HTTPClient *http.Client
+	// Retry is the policy of the retries of the idempotent calls.
+	Retry RetryPolicy
+	// RefreshBefore is how long before its expiration the token is refreshed, before an authenticated call is made.
+	// It should not be longer than the time before the expiration during which the API renews the tokens.
+	// The token is not refreshed automatically if it is zero.
+	RefreshBefore time.Duration
+	// AcceptLanguage is the value of the Accept-Language header, which sets the language of the error messages.
+	AcceptLanguage string
+}
+
+// DefaultOptions is the function that returns the settings of a client that retries the idempotent calls
+// up to three times and refreshes the token in the last 30 seconds before its expiration.
+func DefaultOptions() Options {
+	return Options{
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		Retry: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     5 * time.Second,
+		},
+		RefreshBefore: 30 * time.Second,
+	}
+}
+
+// Client is the client of the API, which keeps the token of the signed in user to make the authenticated calls.
+// It is safe for concurrent use.
+type Client struct {
+	baseURL string
+	options Options
+	// refreshMu serializes the refreshes of the token.
+	refreshMu sync.Mutex
+	// mu guards the token, and is never held during a call to the API.
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// New is the factory function that encapsulates the implementation related to client.
+func New(baseURL string) (*Client, error) {
+	return NewWithOptions(baseURL, DefaultOptions())
+}
+
+// NewWithOptions is the factory function that encapsulates the implementation related to client using the given settings.
+func NewWithOptions(baseURL string, options Options) (*Client, error) {
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		return nil, fmt.Errorf("the base URL %s must start with http:// or https://", baseURL)
+	}
+
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		options: options,
+	}, nil
+}
+
+// Token is the function that returns the token of the signed in user, if any.
+func (c *Client) Token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.token
+}
+
+// SetToken is the function that sets the token the authenticated calls are made with,
+// such as one obtained by another instance of the client. An empty token signs the client out.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setToken(token)
+}
+
+// setToken is the function that sets the token along with its expiration, which is read from its claims without
+// verifying its signature, since only the API can verify it. The token is never refreshed if it has no expiration.
+func (c *Client) setToken(token string) {
+	c.token = token
+	c.expiresAt = time.Time{}
+
+	if token == "" {
+		return
+	}
+
+	claims := jwt.MapClaims{}
+
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return
+	}
+
+	if exp, ok := claims["exp"].(float64); ok {
+		c.expiresAt = time.Unix(int64(exp), 0)
+	}
+}
+
+// currentToken is the function that returns the token of the signed in user,
+// along with whether it is about to expire and so should be refreshed.
+func (c *Client) currentToken() (string, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token == "" {
+		return "", false, ErrNotSignedIn
+	}
+
+	if c.options.RefreshBefore <= 0 || c.expiresAt.IsZero() {
+		return c.token, false, nil
+	}
+
+	untilExpiration := time.Until(c.expiresAt)
+
+	return c.token, untilExpiration <= c.options.RefreshBefore && untilExpiration > 0, nil
+}
+
+// authorization is the function that returns the token the authenticated calls are made with,
+// refreshing it first if it is about to expire. A token that fails to be refreshed is used as long as it is valid,
+// since the API may renew it later. The refreshes are serialized by their own lock, so that the concurrent calls
+// refresh the token only once, while the token itself can be read and set during the call that refreshes it.
+func (c *Client) authorization(ctx context.Context) (string, error) {
+	token, refresh, err := c.currentToken()
+	if err != nil || !refresh {
+		return token, err
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// The token may have been refreshed by a concurrent call in the meantime.
+	token, refresh, err = c.currentToken()
+	if err != nil || !refresh {
+		return token, err
+	}
+
+	renewedToken := tokenhttputilpkg.Token{}
+
+	if _, err := c.send(ctx, request{method: http.MethodPost, path: "/refresh_token", token: token}, &renewedToken); err != nil {
+		return token, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The renewed token is discarded if the token was set meanwhile, such as by signing out.
+	if c.token == token {
+		c.setToken(renewedToken.Text)
+	}
+
+	if c.token == "" {
+		return "", ErrNotSignedIn
+	}
+
+	return c.token, nil
+}
+
+// request is the model of a call to the API.
+type request struct {
+	method  string
+	path    string
+	body    interface{}
+	headers map[string]string
+	// token is the token of the authenticated calls.
+	token string
+}
+
+// do is the function that makes a call to the API, authenticated with the token of the signed in user if required,
+// and decodes the JSON body of its successful response into the given value, if any.
+func (c *Client) do(ctx context.Context, req request, authenticated bool, v interface{}) (http.Header, error) {
+	if authenticated {
+		token, err := c.authorization(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.token = token
+	}
+
+	return c.send(ctx, req, v)
+}
+
+// send is the function that makes a call to the API, retrying it with backoff if it is idempotent
+// and fails with a transient error.
+func (c *Client) send(ctx context.Context, req request, v interface{}) (http.Header, error) {
+	var body []byte
+
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return nil, fmt.Errorf("failed to encode the request body: %w", err)
+		}
+	}
+
+	attempts := 1
+	if isIdempotent(req.method) && c.options.Retry.MaxAttempts > 1 {
+		attempts = c.options.Retry.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		header, retryAfter, err := c.attempt(ctx, req, body, v)
+		if err == nil || attempt >= attempts || !isTransient(ctx, err) {
+			return header, err
+		}
+
+		timer := time.NewTimer(c.backoff(attempt, retryAfter))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt is the function that makes a single call to the API, returning the time to wait before retrying it
+// informed by the Retry-After header of its response, if any.
+func (c *Client) attempt(ctx context.Context, req request, body []byte, v interface{}) (http.Header, time.Duration, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, c.baseURL+"/"+routehttputilpkg.V1+req.path, reqBody)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build the request: %w", err)
+	}
+
+	httpReq.Header.Set("Accept", "application/problem+json, application/json")
+
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	if req.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+req.token)
+	}
+
+	if c.options.AcceptLanguage != "" {
+		httpReq.Header.Set("Accept-Language", c.options.AcceptLanguage)
+	}
+
+	for name, value := range req.headers {
+		httpReq.Header.Set(name, value)
+	}
+
+	resp, err := c.options.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := decodeError(resp)
+		return resp.Header, apiErr.RetryAfter, apiErr
+	}
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return resp.Header, 0, fmt.Errorf("failed to decode the response body: %w", err)
+		}
+	}
+
+	return resp.Header, 0, nil
+}
+
+// backoff is the function that returns the time to wait before a retry: the one informed by the API, if any,
+// or else the exponential backoff of the attempt with jitter, so that the clients do not retry at the same time.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	backoff := retryAfter
+
+	if backoff <= 0 {
+		backoff = c.options.Retry.InitialBackoff << (attempt - 1)
+		backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+
+	if c.options.Retry.MaxBackoff > 0 && backoff > c.options.Retry.MaxBackoff {
+		backoff = c.options.Retry.MaxBackoff
+	}
+
+	return backoff
+}
+
+// isIdempotent is the function that checks if the calls of a method can be retried without changing their outcome.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransient is the function that checks if a call failed with an error that may not happen again,
+// such as a network failure or a response of a server that is overloaded or unavailable.
+func isTransient(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter is the function that parses the Retry-After header, in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+)
+
+// ErrNotSignedIn is the error of the authenticated calls made before signing in.
+var ErrNotSignedIn = errors.New("the client is not signed in")
+
+// FieldError is the model of the error related to a single field of the request.
+type FieldError = responsehttputilpkg.ProblemFieldError
+
+// Error is the error of the calls whose responses are unsuccessful, decoded from their bodies.
+type Error struct {
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Code is the stable machine-readable code of the error, such as USERNAME_TAKEN or TOKEN_EXPIRED.
+	Code string
+	// Message is the message of the error, in the language negotiated by the Accept-Language header.
+	Message string
+	// RequestID is the ID the API identifies the request by in its logs.
+	RequestID string
+	// Details are the parameters of the error, such as the max_bytes of a request entity too large.
+	Details map[string]interface{}
+	// FieldErrors are the errors of the fields of the request that failed the validation, if any.
+	FieldErrors []FieldError
+	// RetryAfter is the time to wait before making the call again, if the API informs it.
+	RetryAfter time.Duration
+}
+
+// Error is the function that returns the message of the error along with its status code and code.
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	}
+
+	return fmt.Sprintf("%d %s (%s): %s", e.StatusCode, http.StatusText(e.StatusCode), e.Code, e.Message)
+}
+
+// HasCode is the function that checks if an error is the one of an unsuccessful response with the given code.
+func HasCode(err error, code string) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// HasStatusCode is the function that checks if an error is the one of an unsuccessful response with the given status code.
+func HasStatusCode(err error, statusCode int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// decodeError is the function that decodes the error of an unsuccessful response from its body, which follows RFC 7807
+// or the legacy model depending on its media type. The status text is the message of a body that cannot be decoded.
+func decodeError(resp *http.Response) *Error {
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+		RequestID:  resp.Header.Get("X-Request-ID"),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return apiErr
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+
+	switch mediaType {
+	case responsehttputilpkg.ContentTypeProblemJSON:
+		problem := responsehttputilpkg.Problem{}
+		if err := json.Unmarshal(body, &problem); err != nil {
+			return apiErr
+		}
+
+		apiErr.Code = problem.Code
+		apiErr.Details = problem.Details
+		apiErr.FieldErrors = problem.Errors
+
+		if problem.Detail != "" {
+			apiErr.Message = problem.Detail
+		}
+
+		if problem.RequestID != "" {
+			apiErr.RequestID = problem.RequestID
+		}
+	case responsehttputilpkg.ContentTypeJSON:
+		legacyErr := responsehttputilpkg.Error{}
+		if err := json.Unmarshal(body, &legacyErr); err != nil || legacyErr.Text == "" {
+			return apiErr
+		}
+
+		apiErr.Message = legacyErr.Text
+
+		if legacyErr.RequestID != "" {
+			apiErr.RequestID = legacyErr.RequestID
+		}
+	}
+
+	return apiErr
+}
@@ -0,0 +1,94 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestChangePassword() {
+	authService := new(authmockservice.Service)
+
+	auth := domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}
+
+	passwords := securitypkg.Passwords{
+		CurrentPassword: "password",
+		NewPassword:     "newpassword",
+	}
+
+	version := 0
+
+	newVersion := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInChangingThePasswordAndReturningTheNewVersionOfTheLogin",
+			SetUp: func(t *testing.T) {
+				version = 0
+				newVersion = 2
+
+				authService.On("ModifyPassword", mock.Anything, auth.UserID.String(), passwords, []int(nil)).Return(newVersion, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInChangingThePasswordOfTheExpectedVersionOfTheLogin",
+			SetUp: func(t *testing.T) {
+				version = 2
+				newVersion = 3
+
+				authService.On("ModifyPassword", mock.Anything, auth.UserID.String(), passwords, []int{version}).Return(newVersion, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheLoginWasModifiedSinceTheExpectedVersion",
+			SetUp: func(t *testing.T) {
+				version = 2
+
+				err := customerror.WithCode(customerror.PreconditionFailed.New("the login was modified by another request"), "LOGIN_VERSION_MISMATCH")
+
+				authService.On("ModifyPassword", mock.Anything, auth.UserID.String(), passwords, []int{version}).Return(0, err)
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			client.SetToken(ts.NewToken(auth, 120))
+
+			returnedVersion, err := client.ChangePassword(context.Background(), passwords, version)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, newVersion, returnedVersion)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.True(t, clientpkg.HasCode(err, "LOGIN_VERSION_MISMATCH"))
+				assert.True(t, clientpkg.HasStatusCode(err, http.StatusPreconditionFailed))
+			}
+		})
+	}
+}
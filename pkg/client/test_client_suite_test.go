@@ -0,0 +1,149 @@
+package client_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
+	userhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/user"
+	apirouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router"
+	authrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/auth"
+	userrouter "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/router/user"
+	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
+	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	openapimiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/openapi"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type Case struct {
+	Context   string
+	SetUp     func(t *testing.T)
+	WantError bool
+	TearDown  func(t *testing.T)
+}
+
+type Cases []Case
+
+type TestSuite struct {
+	suite.Suite
+	AuthN authpkg.IAuth
+	Cases Cases
+}
+
+func (ts *TestSuite) SetupSuite() {
+	rsaPrivateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		log.Panicf("%s", err.Error())
+	}
+
+	ts.AuthN = authpkg.New(authpkg.RSAKeys{
+		PublicKey:  &rsaPrivateKey.PublicKey,
+		PrivateKey: rsaPrivateKey,
+	})
+}
+
+// NewServer is the function that serves the auth's and the user's routes of the API, whose handlers use the given services.
+// The requests and the responses are validated against the OpenAPI document of the routes, so that the client is
+// kept in sync with it, while the middlewares that depend on the database are replaced by ones that do not.
+func (ts *TestSuite) NewServer(authService *authmockservice.Service, userService *usermockservice.Service) *httptest.Server {
+	authService.On("WithDBTrx", mock.Anything).Return(authService).Maybe()
+	userService.On("WithDBTrx", mock.Anything).Return(userService).Maybe()
+
+	routes := make(routehttputilpkg.Routes, 0)
+	routes = append(routes, authrouter.ConfigureRoutes(authhandler.New(authService))...)
+	routes = append(routes, userrouter.ConfigureRoutes(userhandler.New(userService))...)
+
+	document := openapipkg.Generate(openapipkg.Info{}, routes)
+
+	passThrough := func(next http.HandlerFunc) http.HandlerFunc {
+		return next
+	}
+
+	auth := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			tokenString, err := ts.AuthN.ExtractTokenString(r.Header.Get("Authorization"))
+			if err != nil {
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+				return
+			}
+
+			token, err := ts.AuthN.DecodeToken(tokenString)
+			if err != nil {
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+				return
+			}
+
+			auth, err := ts.AuthN.FetchAuthFromToken(token)
+			if err != nil {
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(authmiddlewarepkg.NewContext(r.Context(), auth)))
+		}
+	}
+
+	dbTrx := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(dbtrxmiddlewarepkg.NewContext(r.Context(), &gorm.DB{})))
+		}
+	}
+
+	routes, err := apirouter.Build(routes, apirouter.Middlewares{
+		Metrics:     passThrough,
+		Logging:     passThrough,
+		Tracing:     passThrough,
+		Auth:        auth,
+		AuthRenewal: auth,
+		DBTrx:       dbTrx,
+		Validation: func(route routehttputilpkg.Route) adapterhttputilpkg.Adapter {
+			return openapimiddlewarepkg.Validate(document, route, openapimiddlewarepkg.Config{Requests: true, Responses: true})
+		},
+		RateLimits: map[string]adapterhttputilpkg.Adapter{
+			authrouter.RateLimitPolicySignUp:         nil,
+			authrouter.RateLimitPolicySignIn:         nil,
+			authrouter.RateLimitPolicyChangePassword: nil,
+		},
+	})
+	if err != nil {
+		log.Panicf("%s", err.Error())
+	}
+
+	router := mux.NewRouter()
+
+	for _, route := range routes {
+		router.HandleFunc(route.FullPath(), route.HandlerFunc).Methods(route.Method)
+	}
+
+	return httptest.NewServer(requestidmiddlewarepkg.RequestID()(router.ServeHTTP))
+}
+
+// NewToken is the function that creates a token of an auth that expires in the given number of seconds.
+func (ts *TestSuite) NewToken(auth domainentity.Auth, tokenExpTimeInSec int) string {
+	token, err := ts.AuthN.CreateToken(auth, tokenExpTimeInSec)
+	if err != nil {
+		log.Panicf("%s", err.Error())
+	}
+
+	return token
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,200 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetAllUsers() {
+	authService := new(authmockservice.Service)
+
+	userService := new(usermockservice.Service)
+
+	auth := domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}
+
+	domainUsers := domainentity.Users{
+		{ID: uuid.NewV4(), Username: "username1"},
+		{ID: uuid.NewV4(), Username: "username2"},
+	}
+
+	users := clientpkg.Users{
+		{ID: domainUsers[0].ID, Username: domainUsers[0].Username},
+		{ID: domainUsers[1].ID, Username: domainUsers[1].Username},
+	}
+
+	token := ""
+
+	newToken := ""
+
+	maxAttempts := 0
+
+	statusCode := 0
+
+	concurrentCalls := 0
+
+	var client *clientpkg.Client
+
+	// tokenBlocked is whether reading the token of the client blocked while the token was being refreshed.
+	tokenBlocked := false
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingAllUsers",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+				newToken = token
+				maxAttempts = 1
+
+				userService.On("GetAll", mock.Anything).Return(domainUsers, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingAllUsersAfterRefreshingTheTokenThatIsAboutToExpire",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 10)
+				newToken = ts.NewToken(auth, 120)
+				maxAttempts = 1
+
+				authService.On("RenewToken", mock.Anything, auth).Return(newToken, nil)
+				userService.On("GetAll", mock.Anything).Return(domainUsers, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingAllUsersConcurrentlyAfterRefreshingTheTokenOnceWithoutBlockingTheClient",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 10)
+				newToken = ts.NewToken(auth, 120)
+				maxAttempts = 1
+				concurrentCalls = 5
+
+				authService.On("RenewToken", mock.Anything, auth).Run(func(args mock.Arguments) {
+					done := make(chan struct{})
+
+					go func() {
+						client.Token()
+						close(done)
+					}()
+
+					select {
+					case <-done:
+					case <-time.After(time.Second):
+						tokenBlocked = true
+					}
+				}).Return(newToken, nil).Once()
+				userService.On("GetAll", mock.Anything).Return(domainUsers, nil).Times(concurrentCalls)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingAllUsersAfterRetryingTheCallThatFailedWithATransientError",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+				newToken = token
+				maxAttempts = 3
+
+				userService.On("GetAll", mock.Anything).Return(nil, customerror.ServiceUnavailable.New("the database is unavailable")).Twice()
+				userService.On("GetAll", mock.Anything).Return(domainUsers, nil).Once()
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheCallFailsWithATransientErrorMoreTimesThanTheAttempts",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+				newToken = token
+				maxAttempts = 2
+				statusCode = http.StatusServiceUnavailable
+
+				userService.On("GetAll", mock.Anything).Return(nil, customerror.ServiceUnavailable.New("the database is unavailable")).Times(2)
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailWithoutRetryingIfTheCallFailsWithAnErrorThatIsNotTransient",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+				newToken = token
+				maxAttempts = 3
+				statusCode = http.StatusInternalServerError
+
+				userService.On("GetAll", mock.Anything).Return(nil, customerror.New("failed to get the users")).Once()
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+			userService = new(usermockservice.Service)
+			concurrentCalls = 1
+			tokenBlocked = false
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, userService)
+			defer server.Close()
+
+			options := clientpkg.DefaultOptions()
+			options.Retry = clientpkg.RetryPolicy{
+				MaxAttempts:    maxAttempts,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     10 * time.Millisecond,
+			}
+
+			var err error
+
+			client, err = clientpkg.NewWithOptions(server.URL, options)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			client.SetToken(token)
+
+			returnedUsersList := make([]clientpkg.Users, concurrentCalls)
+			errs := make([]error, concurrentCalls)
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < concurrentCalls; i++ {
+				wg.Add(1)
+
+				go func(i int) {
+					defer wg.Done()
+					returnedUsersList[i], errs[i] = client.GetAllUsers(context.Background())
+				}(i)
+			}
+
+			wg.Wait()
+
+			for i := range errs {
+				if !tc.WantError {
+					assert.Nil(t, errs[i], fmt.Sprintf("Unexpected error: %v", errs[i]))
+					assert.Equal(t, users, returnedUsersList[i])
+					assert.Equal(t, newToken, client.Token())
+				} else {
+					assert.NotNil(t, errs[i], "Predicted error lost.")
+					assert.True(t, clientpkg.HasStatusCode(errs[i], statusCode))
+				}
+			}
+
+			assert.False(t, tokenBlocked, "The token was not readable while it was being refreshed.")
+
+			authService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
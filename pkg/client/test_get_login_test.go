@@ -0,0 +1,80 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestGetLogin() {
+	authService := new(authmockservice.Service)
+
+	auth := domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}
+
+	domainLogin := domainentity.Login{
+		ID:       uuid.NewV4(),
+		UserID:   auth.UserID,
+		Username: "username",
+		Password: "password",
+		Version:  3,
+	}
+
+	login := clientpkg.Login{UserID: domainLogin.UserID, Username: domainLogin.Username}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheLoginAlongWithItsVersion",
+			SetUp: func(t *testing.T) {
+				authService.On("GetLogin", mock.Anything, auth.UserID.String()).Return(domainLogin, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheUserIsNotRegistered",
+			SetUp: func(t *testing.T) {
+				err := customerror.WithCode(customerror.NotFound.New("the user who owns this token is not registered"), "USER_NOT_REGISTERED")
+
+				authService.On("GetLogin", mock.Anything, auth.UserID.String()).Return(domainentity.Login{}, err)
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			client.SetToken(ts.NewToken(auth, 120))
+
+			returnedLogin, returnedVersion, err := client.GetLogin(context.Background())
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, login, returnedLogin)
+				assert.Equal(t, domainLogin.Version, returnedVersion)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.True(t, clientpkg.HasCode(err, "USER_NOT_REGISTERED"))
+				assert.True(t, clientpkg.HasStatusCode(err, http.StatusNotFound))
+			}
+		})
+	}
+}
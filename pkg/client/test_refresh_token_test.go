@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestRefreshToken() {
+	authService := new(authmockservice.Service)
+
+	auth := domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}
+
+	token := ""
+
+	newToken := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRefreshingTheTokenAndKeepingTheNewOne",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+				newToken = ts.NewToken(auth, 240)
+
+				authService.On("RenewToken", mock.Anything, auth).Return(newToken, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheClientIsNotSignedIn",
+			SetUp: func(t *testing.T) {
+				token = ""
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			client.SetToken(token)
+
+			returnedToken, err := client.RefreshToken(context.Background())
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, newToken, returnedToken)
+				assert.Equal(t, newToken, client.Token())
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.ErrorIs(t, err, clientpkg.ErrNotSignedIn)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v5"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestSignIn() {
+	authService := new(authmockservice.Service)
+
+	credentials := securitypkg.Credentials{}
+
+	token := ""
+
+	apiErr := &clientpkg.Error{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSigningInAndKeepingTheToken",
+			SetUp: func(t *testing.T) {
+				credentials = securitypkg.Credentials{
+					Username: "username",
+					Password: "password",
+				}
+
+				token = ts.NewToken(domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}, 120)
+
+				authService.On("LogIn", mock.Anything, credentials).Return(token, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfThePasswordIsWrong",
+			SetUp: func(t *testing.T) {
+				credentials = securitypkg.Credentials{
+					Username: "username",
+					Password: fake.Password(true, true, true, false, false, 8),
+				}
+
+				err := customerror.WithCode(customerror.Unauthorized.New("the password is invalid"), "WRONG_PASSWORD")
+
+				authService.On("LogIn", mock.Anything, credentials).Return("", err)
+
+				apiErr = &clientpkg.Error{
+					StatusCode: http.StatusUnauthorized,
+					Code:       "WRONG_PASSWORD",
+					Message:    "the password is invalid",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheCredentialsDoNotMatchTheSpecification",
+			SetUp: func(t *testing.T) {
+				credentials = securitypkg.Credentials{
+					Username: "user",
+					Password: "password",
+				}
+
+				apiErr = &clientpkg.Error{
+					StatusCode: http.StatusBadRequest,
+					Code:       "VALIDATION_FAILED",
+					Message:    "username: must match the pattern ^[a-zA-Z0-9]{5,}$",
+					FieldErrors: []clientpkg.FieldError{
+						{
+							Field:   "username",
+							Rule:    "pattern",
+							Params:  map[string]string{"pattern": "^[a-zA-Z0-9]{5,}$"},
+							Message: "must match the pattern ^[a-zA-Z0-9]{5,}$",
+						},
+					},
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			returnedToken, err := client.SignIn(context.Background(), credentials)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, token, returnedToken)
+				assert.Equal(t, token, client.Token())
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+
+				returnedAPIErr := &clientpkg.Error{}
+				assert.True(t, errors.As(err, &returnedAPIErr))
+				assert.NotEmpty(t, returnedAPIErr.RequestID)
+				returnedAPIErr.RequestID = ""
+				assert.Equal(t, apiErr, returnedAPIErr)
+				assert.Empty(t, client.Token())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestSignOut() {
+	authService := new(authmockservice.Service)
+
+	auth := domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}
+
+	token := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSigningOutAndForgettingTheToken",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, 120)
+
+				authService.On("LogOut", mock.Anything, auth.ID.String()).Return(nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheTokenHasExpired",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(auth, -60)
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			client.SetToken(token)
+
+			err = client.SignOut(context.Background())
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Empty(t, client.Token())
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.True(t, clientpkg.HasCode(err, "TOKEN_EXPIRED"))
+				assert.True(t, clientpkg.HasStatusCode(err, http.StatusUnauthorized))
+				assert.Equal(t, token, client.Token())
+			}
+		})
+	}
+}
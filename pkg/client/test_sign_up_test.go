@@ -0,0 +1,77 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authmockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/auth"
+	usermockservice "github.com/icaroribeiro/go-code-challenge-template/internal/core/ports/application/mockservice/user"
+	clientpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/client"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ts *TestSuite) TestSignUp() {
+	authService := new(authmockservice.Service)
+
+	credentials := securitypkg.Credentials{
+		Username: "username",
+		Password: "password",
+	}
+
+	token := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSigningUpAndKeepingTheToken",
+			SetUp: func(t *testing.T) {
+				token = ts.NewToken(domainentity.Auth{ID: uuid.NewV4(), UserID: uuid.NewV4()}, 120)
+
+				authService.On("Register", mock.Anything, credentials).Return(token, nil)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheUsernameIsAlreadyTaken",
+			SetUp: func(t *testing.T) {
+				err := customerror.WithCode(customerror.Conflict.Newf("the username %s is already registered", credentials.Username), "USERNAME_TAKEN")
+
+				authService.On("Register", mock.Anything, credentials).Return("", err)
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			authService = new(authmockservice.Service)
+
+			tc.SetUp(t)
+
+			server := ts.NewServer(authService, new(usermockservice.Service))
+			defer server.Close()
+
+			client, err := clientpkg.New(server.URL)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			returnedToken, err := client.SignUp(context.Background(), credentials)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, token, returnedToken)
+				assert.Equal(t, token, client.Token())
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.True(t, clientpkg.HasCode(err, "USERNAME_TAKEN"))
+				assert.True(t, clientpkg.HasStatusCode(err, http.StatusConflict))
+				assert.Empty(t, client.Token())
+			}
+		})
+	}
+}
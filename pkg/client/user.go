@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// User is the user's model returned by the API.
+type User struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+// Users is a slice of User.
+type Users []User
+
+// GetAllUsers is the function that gets the list of all users.
+func (c *Client) GetAllUsers(ctx context.Context) (Users, error) {
+	users := Users{}
+
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: "/users"}, true, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
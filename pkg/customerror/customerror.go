@@ -3,14 +3,37 @@ package customerror
 import (
 	"errors"
 	"fmt"
+	"io"
+	"runtime"
+	"strings"
 )
 
 // ErrorType is the type of an error.
 type ErrorType int
 
+// maxStackDepth is the maximum number of frames captured when an error is created.
+const maxStackDepth = 32
+
+// defaultPublicMessage is the message returned to clients for the errors that carry neither a type nor a public message,
+// since their messages may reveal internal details such as raw SQL errors.
+const defaultPublicMessage = "an unexpected error occurred"
+
 type customError struct {
-	ErrorType ErrorType
-	OrigError error
+	ErrorType     ErrorType
+	OrigError     error
+	Code          string
+	PublicMessage string
+	Details       map[string]interface{}
+	FieldErrors   []FieldError
+	stack         []uintptr
+}
+
+// FieldError is the error related to a single field of a request, such as a failed validation.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Params  map[string]string
+	Message string
 }
 
 const (
@@ -26,38 +49,307 @@ const (
 	Conflict
 	//UnprocessableEntity error.
 	UnprocessableEntity
+	// ServiceUnavailable error.
+	ServiceUnavailable
+	// PreconditionFailed error.
+	PreconditionFailed
+	// TooManyRequests error.
+	TooManyRequests
+	// RequestEntityTooLarge error.
+	RequestEntityTooLarge
+	// Forbidden error.
+	Forbidden
+	// Locked error.
+	Locked
 )
 
+// typedError is the interface implemented by the errors of other packages that carry an error type.
+type typedError interface {
+	ErrorType() ErrorType
+}
+
+// fieldError is the interface implemented by the errors of other packages that carry errors of fields,
+// such as the violations of a validation.
+type fieldError interface {
+	FieldErrors() []FieldError
+}
+
+// callers is the function that captures the stack of the function that created the error.
+func callers() []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	// The frames of runtime.Callers, callers and the constructor itself are skipped.
+	n := runtime.Callers(3, pcs)
+
+	return pcs[:n]
+}
+
 // New is the function that creates a non-type error.
 func New(msg string) error {
-	return customError{ErrorType: NoType, OrigError: errors.New(msg)}
+	return &customError{ErrorType: NoType, OrigError: errors.New(msg), stack: callers()}
 }
 
 // Newf is the function that creates a non-type error with formatted message.
+// The %w verb wraps the error, which remains available to errors.Is and errors.As.
 func Newf(msg string, args ...interface{}) error {
-	return customError{ErrorType: NoType, OrigError: fmt.Errorf(msg, args...)}
+	return &customError{ErrorType: NoType, OrigError: fmt.Errorf(msg, args...), stack: callers()}
+}
+
+// Wrap is the function that wraps an error with a message, keeping its type.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &customError{ErrorType: GetType(err), OrigError: fmt.Errorf("%s: %w", msg, err), stack: callers()}
+}
+
+// Wrapf is the function that wraps an error with a formatted message, keeping its type.
+func Wrapf(err error, msg string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	return &customError{ErrorType: GetType(err), OrigError: fmt.Errorf("%s: %w", fmt.Sprintf(msg, args...), err), stack: callers()}
 }
 
 // Error is the function that returns the message of a customError.
-func (error customError) Error() string {
+func (error *customError) Error() string {
 	return error.OrigError.Error()
 }
 
+// Unwrap is the function that returns the error wrapped by a customError.
+func (error *customError) Unwrap() error {
+	return error.OrigError
+}
+
+// Format is the function that formats a customError, printing its stack along with its message with the %+v verb.
+func (error *customError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		io.WriteString(s, error.Error())
+		io.WriteString(s, "\n")
+		io.WriteString(s, error.stackTrace())
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", error.Error())
+	default:
+		io.WriteString(s, error.Error())
+	}
+}
+
+// stackTrace is the function that returns the stack captured when the customError was created, one frame per line.
+func (error *customError) stackTrace() string {
+	var builder strings.Builder
+
+	frames := runtime.CallersFrames(error.stack)
+
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			fmt.Fprintf(&builder, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return builder.String()
+}
+
 // New is the function that creates a new error using an error type.
 func (errorType ErrorType) New(msg string) error {
-	return customError{ErrorType: errorType, OrigError: errors.New(msg)}
+	return &customError{ErrorType: errorType, OrigError: errors.New(msg), stack: callers()}
 }
 
 // Newf is the function that creates a new error using an error type with formatted message.
+// The %w verb wraps the error, which remains available to errors.Is and errors.As.
 func (errorType ErrorType) Newf(msg string, args ...interface{}) error {
-	return customError{ErrorType: errorType, OrigError: fmt.Errorf(msg, args...)}
+	return &customError{ErrorType: errorType, OrigError: fmt.Errorf(msg, args...), stack: callers()}
+}
+
+// Wrap is the function that wraps an error with a message using an error type.
+func (errorType ErrorType) Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &customError{ErrorType: errorType, OrigError: fmt.Errorf("%s: %w", msg, err), stack: callers()}
 }
 
 // GetType is the function that gets the type of the error.
+// The chain of wrapped errors is searched, so the outermost error that carries a type determines it.
 func GetType(err error) ErrorType {
-	if customError, ok := err.(customError); ok {
-		return customError.ErrorType
+	for err != nil {
+		switch e := err.(type) {
+		case *customError:
+			if e.ErrorType != NoType {
+				return e.ErrorType
+			}
+		case typedError:
+			return e.ErrorType()
+		}
+
+		err = errors.Unwrap(err)
 	}
 
 	return NoType
 }
+
+// annotate is the function that returns a copy of the error to be annotated.
+// An error that is not a customError is wrapped by one that keeps its type.
+func annotate(err error) *customError {
+	if customError, ok := err.(*customError); ok {
+		annotated := *customError
+		return &annotated
+	}
+
+	return &customError{ErrorType: GetType(err), OrigError: err, stack: callers()}
+}
+
+// find is the function that searches the chain of wrapped errors for the outermost customError that matches.
+func find(err error, match func(*customError) bool) *customError {
+	for err != nil {
+		if customError, ok := err.(*customError); ok && match(customError) {
+			return customError
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return nil
+}
+
+// WithCode is the function that attaches a machine-readable code to the error, such as USERNAME_TAKEN,
+// so that the clients do not need to match the messages. The type of the error is kept.
+func WithCode(err error, code string) error {
+	annotated := annotate(err)
+	annotated.Code = code
+
+	return annotated
+}
+
+// GetCode is the function that gets the code of the error, or an empty string when it has none.
+func GetCode(err error) string {
+	if customError := find(err, func(e *customError) bool { return e.Code != "" }); customError != nil {
+		return customError.Code
+	}
+
+	return ""
+}
+
+// WithPublicMessage is the function that attaches the message returned to clients to the error,
+// so that its internal message, which may reveal details such as raw SQL errors, is only logged.
+func WithPublicMessage(err error, msg string) error {
+	annotated := annotate(err)
+	annotated.PublicMessage = msg
+
+	return annotated
+}
+
+// GetPublicMessage is the function that gets the message of the error that is safe to be returned to clients.
+// The message of an error with a type is considered safe, while the one of an error without a type is replaced
+// by a generic message unless a public message was attached to it.
+func GetPublicMessage(err error) string {
+	if customError := find(err, func(e *customError) bool { return e.PublicMessage != "" }); customError != nil {
+		return customError.PublicMessage
+	}
+
+	if GetType(err) != NoType {
+		return err.Error()
+	}
+
+	return defaultPublicMessage
+}
+
+// WithDetails is the function that attaches details to the error as alternating keys and values,
+// such as WithDetails(err, "username", username). A key without value is ignored.
+func WithDetails(err error, keysAndValues ...interface{}) error {
+	annotated := annotate(err)
+
+	details := make(map[string]interface{}, len(annotated.Details)+len(keysAndValues)/2)
+	for key, value := range annotated.Details {
+		details[key] = value
+	}
+
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		details[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+
+	annotated.Details = details
+
+	return annotated
+}
+
+// GetDetails is the function that gets the details attached to the error and to the errors it wraps.
+// The details of the outer errors take precedence.
+func GetDetails(err error) map[string]interface{} {
+	var details map[string]interface{}
+
+	for err != nil {
+		if customError, ok := err.(*customError); ok {
+			for key, value := range customError.Details {
+				if details == nil {
+					details = make(map[string]interface{})
+				}
+
+				if _, ok := details[key]; !ok {
+					details[key] = value
+				}
+			}
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return details
+}
+
+// WithFieldErrors is the function that attaches the errors of the fields of a request to the error.
+// The type and the code of the error are kept.
+func WithFieldErrors(err error, fieldErrors ...FieldError) error {
+	annotated := annotate(err)
+	annotated.FieldErrors = append(append([]FieldError{}, annotated.FieldErrors...), fieldErrors...)
+
+	return annotated
+}
+
+// GetFieldErrors is the function that gets the errors of the fields of a request attached to the error
+// or carried by the errors it wraps.
+func GetFieldErrors(err error) []FieldError {
+	for err != nil {
+		switch e := err.(type) {
+		case *customError:
+			if len(e.FieldErrors) > 0 {
+				return e.FieldErrors
+			}
+		case fieldError:
+			if fieldErrors := e.FieldErrors(); len(fieldErrors) > 0 {
+				return fieldErrors
+			}
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return nil
+}
+
+// GetStack is the function that gets the stack captured when the innermost customError of the chain was created,
+// which is the closest to where the error happened.
+func GetStack(err error) string {
+	var innermost *customError
+
+	for err != nil {
+		if customError, ok := err.(*customError); ok && len(customError.stack) > 0 {
+			innermost = customError
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	if innermost == nil {
+		return ""
+	}
+
+	return innermost.stackTrace()
+}
@@ -0,0 +1,50 @@
+package customerror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGetPublicMessage() {
+	var err error
+	msg := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheMessageOfAnErrorWithAType",
+			SetUp: func(t *testing.T) {
+				err = customerror.BadRequest.New("the username is invalid")
+				msg = "the username is invalid"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheAttachedPublicMessageOfAWrappedError",
+			SetUp: func(t *testing.T) {
+				internalErr := customerror.Newf("failed to insert the user: %w", errors.New("pq: relation users does not exist"))
+				err = fmt.Errorf("wrapped: %w", customerror.WithPublicMessage(internalErr, "the user could not be registered"))
+				msg = "the user could not be registered"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingAGenericMessageOfAnErrorWithoutAType",
+			SetUp: func(t *testing.T) {
+				err = customerror.New("pq: relation users does not exist")
+				msg = "an unexpected error occurred"
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedMsg := customerror.GetPublicMessage(err)
+
+			assert.Equal(t, msg, returnedMsg)
+		})
+	}
+}
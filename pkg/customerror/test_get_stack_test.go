@@ -0,0 +1,51 @@
+package customerror_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newError() error {
+	return customerror.New("failed")
+}
+
+func (ts *TestSuite) TestGetStack() {
+	var err error
+	isCaptured := false
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheStackOfTheInnermostError",
+			SetUp: func(t *testing.T) {
+				err = customerror.Wrap(newError(), "wrapped")
+				isCaptured = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingNoStackOfAnErrorOfAnotherPackage",
+			SetUp: func(t *testing.T) {
+				err = errors.New("failed")
+				isCaptured = false
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			stack := customerror.GetStack(err)
+
+			if isCaptured {
+				assert.Contains(t, stack, "customerror_test.newError")
+				assert.Contains(t, fmt.Sprintf("%+v", err), "TestGetStack")
+			} else {
+				assert.Empty(t, stack)
+			}
+		})
+	}
+}
@@ -2,12 +2,23 @@ package customerror_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
 	"github.com/stretchr/testify/assert"
 )
 
+type typedError struct{}
+
+func (typedError) Error() string {
+	return "failed"
+}
+
+func (typedError) ErrorType() customerror.ErrorType {
+	return customerror.Conflict
+}
+
 func (ts *TestSuite) TestGetType() {
 	err := errors.New("")
 	errorType := customerror.NoType
@@ -20,6 +31,20 @@ func (ts *TestSuite) TestGetType() {
 				errorType = customerror.BadRequest
 			},
 		},
+		{
+			Context: "ItShouldSucceedInGettingATypeWhenAWrappedErrorCarriesAType",
+			SetUp: func(t *testing.T) {
+				err = fmt.Errorf("wrapped: %w", typedError{})
+				errorType = customerror.Conflict
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingATypeWhenAnErrorWithATypeIsWrappedWithTheVerbW",
+			SetUp: func(t *testing.T) {
+				err = fmt.Errorf("wrapped: %w", customerror.Newf("failed: %w", customerror.NotFound.New("failed")))
+				errorType = customerror.NotFound
+			},
+		},
 		{
 			Context: "ItShouldSucceedInGettingNoTypeWhenTheErrorHasNoType",
 			SetUp: func(t *testing.T) {
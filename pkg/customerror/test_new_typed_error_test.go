@@ -30,7 +30,8 @@ func (ts *TestSuite) TestNewTypedError() {
 
 			returnedError := errorType.New(msg)
 
-			assert.Equal(t, err, returnedError)
+			assert.Equal(t, err.Error(), returnedError.Error())
+			assert.Equal(t, customerror.GetType(err), customerror.GetType(returnedError))
 		})
 	}
 }
@@ -28,7 +28,8 @@ func (ts *TestSuite) TestNewf() {
 
 			returnedError := customerror.Newf("%s", msg)
 
-			assert.Equal(t, err, returnedError)
+			assert.Equal(t, err.Error(), returnedError.Error())
+			assert.Equal(t, customerror.GetType(err), customerror.GetType(returnedError))
 		})
 	}
 }
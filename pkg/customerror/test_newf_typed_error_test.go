@@ -30,7 +30,8 @@ func (ts *TestSuite) TestNewfTypedError() {
 
 			returnedError := errorType.Newf(msg)
 
-			assert.Equal(t, err, returnedError)
+			assert.Equal(t, err.Error(), returnedError.Error())
+			assert.Equal(t, customerror.GetType(err), customerror.GetType(returnedError))
 		})
 	}
 }
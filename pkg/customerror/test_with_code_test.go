@@ -0,0 +1,46 @@
+package customerror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWithCode() {
+	err := errors.New("")
+	errorType := customerror.NoType
+
+	code := "USERNAME_TAKEN"
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAttachingACodeToAnErrorWithAType",
+			SetUp: func(t *testing.T) {
+				err = customerror.Conflict.New("failed")
+				errorType = customerror.Conflict
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAttachingACodeToAnErrorOfAnotherPackage",
+			SetUp: func(t *testing.T) {
+				err = typedError{}
+				errorType = customerror.Conflict
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedErr := customerror.WithCode(err, code)
+
+			assert.Equal(t, code, customerror.GetCode(returnedErr))
+			assert.Equal(t, errorType, customerror.GetType(returnedErr))
+			assert.Equal(t, "failed", returnedErr.Error())
+			assert.Empty(t, customerror.GetCode(err))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package customerror_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWithDetails() {
+	var err error
+	details := map[string]interface{}{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAttachingTheDetailsAsKeysAndValues",
+			SetUp: func(t *testing.T) {
+				err = customerror.WithDetails(customerror.Conflict.New("failed"), "username", "user1", "version", 2, "ignored")
+				details = map[string]interface{}{"username": "user1", "version": 2}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheDetailsOfTheWrappedErrorsWithPrecedenceOfTheOuterOnes",
+			SetUp: func(t *testing.T) {
+				innerErr := customerror.WithDetails(customerror.Conflict.New("failed"), "username", "user1", "version", 1)
+				err = customerror.WithDetails(fmt.Errorf("wrapped: %w", innerErr), "version", 2)
+				details = map[string]interface{}{"username": "user1", "version": 2}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedDetails := customerror.GetDetails(err)
+
+			assert.Equal(t, details, returnedDetails)
+			assert.Equal(t, customerror.Conflict, customerror.GetType(err))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package customerror_test
+
+import (
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWithFieldErrors() {
+	fieldErrors := []customerror.FieldError{
+		{Field: "username", Message: "failed"},
+		{Field: "password", Message: "failed"},
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAttachingTheFieldErrorsAndKeepingTheTypeAndTheCode",
+			SetUp:   func(t *testing.T) {},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			err := customerror.WithCode(customerror.BadRequest.New("failed"), "VALIDATION_FAILED")
+
+			returnedErr := customerror.WithFieldErrors(err, fieldErrors...)
+
+			assert.Equal(t, fieldErrors, customerror.GetFieldErrors(returnedErr))
+			assert.Equal(t, "VALIDATION_FAILED", customerror.GetCode(returnedErr))
+			assert.Equal(t, customerror.BadRequest, customerror.GetType(returnedErr))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package customerror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWrap() {
+	var err error
+	errorType := customerror.NoType
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInWrappingAnErrorAndKeepingItsType",
+			SetUp: func(t *testing.T) {
+				err = customerror.Conflict.New("failed")
+				errorType = customerror.Conflict
+			},
+		},
+		{
+			Context: "ItShouldSucceedInWrappingAnErrorOfAnotherPackage",
+			SetUp: func(t *testing.T) {
+				err = typedError{}
+				errorType = customerror.Conflict
+			},
+		},
+		{
+			Context: "ItShouldSucceedInWrappingAnErrorWithoutAType",
+			SetUp: func(t *testing.T) {
+				err = errors.New("failed")
+				errorType = customerror.NoType
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedErr := customerror.Wrap(err, "wrapped")
+
+			assert.Equal(t, "wrapped: failed", returnedErr.Error())
+			assert.Equal(t, errorType, customerror.GetType(returnedErr))
+			assert.True(t, errors.Is(returnedErr, err))
+			assert.Equal(t, err, errors.Unwrap(errors.Unwrap(returnedErr)))
+
+			var typedErr typedError
+			if errors.As(err, &typedErr) {
+				assert.True(t, errors.As(returnedErr, &typedErr))
+			}
+
+			assert.Nil(t, customerror.Wrap(nil, "wrapped"))
+		})
+	}
+}
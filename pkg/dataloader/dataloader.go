@@ -0,0 +1,95 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchFunc is the function that fetches the values of a batch of keys at once, such as with a single query.
+// A key missing from the returned map is resolved to the zero value of the type of the values.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader is the loader that batches the keys queued until one of their values is needed and caches the fetched values,
+// so that the values of many keys are not fetched one by one. A loader is meant to last a single request.
+type Loader[K comparable, V any] struct {
+	batchFunc BatchFunc[K, V]
+	mutex     sync.Mutex
+	pending   []K
+	queued    map[K]struct{}
+	values    map[K]V
+	errs      map[K]error
+}
+
+// New is the factory function that encapsulates the implementation related to loader.
+func New[K comparable, V any](batchFunc BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		batchFunc: batchFunc,
+		queued:    make(map[K]struct{}),
+		values:    make(map[K]V),
+		errs:      make(map[K]error),
+	}
+}
+
+// Load is the function that queues a key and returns the thunk that gets its value.
+// The first thunk called fetches all the keys queued so far in a single batch, so the callers that queue their keys
+// before calling the thunks, as the GraphQL resolvers of the same level do, share it.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) func() (V, error) {
+	l.mutex.Lock()
+	l.enqueue(key)
+	l.mutex.Unlock()
+
+	return func() (V, error) {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		if _, ok := l.queued[key]; ok {
+			l.dispatch(ctx)
+		}
+
+		if err, ok := l.errs[key]; ok {
+			var zero V
+			return zero, err
+		}
+
+		return l.values[key], nil
+	}
+}
+
+// enqueue is the function that queues a key, unless it is already queued or fetched.
+func (l *Loader[K, V]) enqueue(key K) {
+	if _, ok := l.queued[key]; ok || l.isDone(key) {
+		return
+	}
+
+	l.queued[key] = struct{}{}
+	l.pending = append(l.pending, key)
+}
+
+// isDone is the function that checks if the value of a key, or the error of fetching it, is cached.
+func (l *Loader[K, V]) isDone(key K) bool {
+	if _, ok := l.values[key]; ok {
+		return true
+	}
+
+	_, ok := l.errs[key]
+	return ok
+}
+
+// dispatch is the function that fetches the queued keys in a single batch.
+// The error of the batch is the error of each of its keys.
+func (l *Loader[K, V]) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	l.queued = make(map[K]struct{})
+
+	values, err := l.batchFunc(ctx, keys)
+
+	for _, key := range keys {
+		if err != nil {
+			l.errs[key] = err
+			continue
+		}
+
+		l.values[key] = values[key]
+	}
+}
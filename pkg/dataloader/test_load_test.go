@@ -0,0 +1,123 @@
+package dataloader_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	dataloaderpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/dataloader"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestLoad() {
+	ctx := context.Background()
+
+	var batches [][]string
+
+	var batchErr error
+
+	batchFunc := func(ctx context.Context, keys []string) (map[string]int, error) {
+		batches = append(batches, keys)
+
+		if batchErr != nil {
+			return nil, batchErr
+		}
+
+		values := make(map[string]int)
+		for _, key := range keys {
+			if key != "missing" {
+				values[key] = len(key)
+			}
+		}
+
+		return values, nil
+	}
+
+	var loader *dataloaderpkg.Loader[string, int]
+
+	keys := make([]string, 0)
+
+	values := make([]int, 0)
+
+	wantBatches := make([][]string, 0)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLoadingTheQueuedKeysInASingleBatch",
+			SetUp: func(t *testing.T) {
+				loader = dataloaderpkg.New(batchFunc)
+				batchErr = nil
+				keys = []string{"a", "bb", "a", "ccc"}
+				values = []int{1, 2, 1, 3}
+				wantBatches = [][]string{{"a", "bb", "ccc"}}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLoadingTheZeroValueOfTheKeysThatAreNotFound",
+			SetUp: func(t *testing.T) {
+				loader = dataloaderpkg.New(batchFunc)
+				batchErr = nil
+				keys = []string{"missing", "dd"}
+				values = []int{0, 2}
+				wantBatches = [][]string{{"missing", "dd"}}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLoadingTheCachedKeysWithoutFetchingThemAgain",
+			SetUp: func(t *testing.T) {
+				loader = dataloaderpkg.New(batchFunc)
+				batchErr = nil
+
+				_, err := loader.Load(ctx, "a")()
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				keys = []string{"a", "eeeee"}
+				values = []int{1, 5}
+				wantBatches = [][]string{{"a"}, {"eeeee"}}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheBatchFails",
+			SetUp: func(t *testing.T) {
+				loader = dataloaderpkg.New(batchFunc)
+				batchErr = customerror.ServiceUnavailable.New("failed")
+				keys = []string{"a", "bb"}
+				values = []int{0, 0}
+				wantBatches = [][]string{{"a", "bb"}}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			batches = nil
+
+			tc.SetUp(t)
+
+			thunks := make([]func() (int, error), 0, len(keys))
+			for _, key := range keys {
+				thunks = append(thunks, loader.Load(ctx, key))
+			}
+
+			for i, thunk := range thunks {
+				value, err := thunk()
+
+				if !tc.WantError {
+					assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				} else {
+					assert.NotNil(t, err, "Predicted error lost.")
+					assert.Equal(t, customerror.ServiceUnavailable, customerror.GetType(err))
+				}
+
+				assert.Equal(t, values[i], value)
+			}
+
+			assert.Equal(t, wantBatches, batches)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package datastore
+
+import (
+	"database/sql"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"gorm.io/gorm"
+)
+
+const maxConnectRetryInterval = time.Second * 10
+
+// ConnectionConfig is the model of the settings used to establish the database connection and tune its pool.
+type ConnectionConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// ConnectTimeout is the overall deadline for retrying the connection at startup.
+	// When it is zero, the connection is attempted only once.
+	ConnectTimeout time.Duration
+	// ConnectRetryInterval is the initial interval between two connection attempts, which doubles on every failure.
+	ConnectRetryInterval time.Duration
+}
+
+// NewConnectionConfig is the function that builds the connection settings from the database configuration.
+// The entries that are not informed keep the database/sql defaults.
+func NewConnectionConfig(dbConfig map[string]string) (ConnectionConfig, error) {
+	config := ConnectionConfig{
+		ConnectRetryInterval: time.Millisecond * 500,
+	}
+
+	var err error
+
+	if config.MaxOpenConns, err = parseInt(dbConfig, "MAX_OPEN_CONNS"); err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	if config.MaxIdleConns, err = parseInt(dbConfig, "MAX_IDLE_CONNS"); err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	if config.ConnMaxLifetime, err = parseDurationInSec(dbConfig, "CONN_MAX_LIFETIME_IN_SEC"); err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	if config.ConnMaxIdleTime, err = parseDurationInSec(dbConfig, "CONN_MAX_IDLE_TIME_IN_SEC"); err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	if config.ConnectTimeout, err = parseDurationInSec(dbConfig, "CONNECT_TIMEOUT_IN_SEC"); err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	retryIntervalInMs, err := parseInt(dbConfig, "CONNECT_RETRY_INTERVAL_IN_MS")
+	if err != nil {
+		return ConnectionConfig{}, err
+	}
+
+	if retryIntervalInMs > 0 {
+		config.ConnectRetryInterval = time.Duration(retryIntervalInMs) * time.Millisecond
+	}
+
+	return config, nil
+}
+
+// Apply is the function that configures the connection pool of a database.
+func (c ConnectionConfig) Apply(sqlDB *sql.DB) {
+	if c.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(c.MaxOpenConns)
+	}
+
+	if c.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(c.MaxIdleConns)
+	}
+
+	if c.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(c.ConnMaxLifetime)
+	}
+
+	if c.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+	}
+}
+
+// Open is the function that opens a database connection, retrying with exponential backoff
+// until the connection succeeds or the overall deadline is reached.
+func (c ConnectionConfig) Open(dialector gorm.Dialector, gormConfig *gorm.Config) (*gorm.DB, error) {
+	deadline := time.Now().Add(c.ConnectTimeout)
+	interval := c.ConnectRetryInterval
+
+	for attempt := 1; ; attempt++ {
+		db, err := gorm.Open(dialector, gormConfig)
+		if err == nil {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return nil, err
+			}
+
+			c.Apply(sqlDB)
+
+			return db, nil
+		}
+
+		// The pool opened by the failed attempt is released before trying again.
+		if db != nil {
+			if sqlDB, dbErr := db.DB(); dbErr == nil {
+				sqlDB.Close()
+			}
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return nil, err
+		}
+
+		slog.Warn("database connection attempt failed, retrying",
+			slog.Int("attempt", attempt), slog.Duration("interval", interval), slog.Any("error", err))
+		time.Sleep(interval)
+
+		interval *= 2
+		if interval > maxConnectRetryInterval {
+			interval = maxConnectRetryInterval
+		}
+	}
+}
+
+// parseInt is the function that converts an optional entry of the database configuration to an integer.
+func parseInt(dbConfig map[string]string, key string) (int, error) {
+	value := dbConfig[key]
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, customerror.Newf("the database config %s must be an integer: %s", key, err.Error())
+	}
+
+	return number, nil
+}
+
+// parseDurationInSec is the function that converts an optional entry of the database configuration given in seconds.
+func parseDurationInSec(dbConfig map[string]string, key string) (time.Duration, error) {
+	seconds, err := parseInt(dbConfig, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -16,6 +16,8 @@ func New(dbConfig map[string]string) (IDatastore, error) {
 	switch driver {
 	case "postgres":
 		return NewPostgresDriver(dbConfig)
+	case "mysql":
+		return NewMySQLDriver(dbConfig)
 	}
 
 	return nil, customerror.Newf("sql database driver %s is not recognized", driver)
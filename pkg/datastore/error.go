@@ -0,0 +1,233 @@
+package datastore
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ErrorKind is the kind of a database error.
+type ErrorKind int
+
+const (
+	// UnknownError kind.
+	UnknownError ErrorKind = iota
+	// UniqueViolation kind.
+	UniqueViolation
+	// ForeignKeyViolation kind.
+	ForeignKeyViolation
+	// NotNullViolation kind.
+	NotNullViolation
+	// CheckViolation kind.
+	CheckViolation
+	// SerializationFailure kind.
+	SerializationFailure
+	// Deadlock kind.
+	Deadlock
+	// ConnectionFailure kind.
+	ConnectionFailure
+)
+
+var postgresErrorKinds = map[string]ErrorKind{
+	"23505": UniqueViolation,
+	"23503": ForeignKeyViolation,
+	"23502": NotNullViolation,
+	"23514": CheckViolation,
+	"40001": SerializationFailure,
+	"40P01": Deadlock,
+}
+
+var mysqlErrorKinds = map[uint16]ErrorKind{
+	1062: UniqueViolation,
+	1451: ForeignKeyViolation,
+	1452: ForeignKeyViolation,
+	1048: NotNullViolation,
+	1364: NotNullViolation,
+	3819: CheckViolation,
+	1213: Deadlock,
+}
+
+// errorKindDetails holds the safe message returned to clients and the error type of every kind of database error.
+var errorKindDetails = map[ErrorKind]struct {
+	msg       string
+	errorType customerror.ErrorType
+}{
+	UniqueViolation:      {msg: "the record conflicts with an existing one", errorType: customerror.Conflict},
+	ForeignKeyViolation:  {msg: "the record references another one that does not exist", errorType: customerror.UnprocessableEntity},
+	NotNullViolation:     {msg: "a required field of the record was not informed", errorType: customerror.BadRequest},
+	CheckViolation:       {msg: "a field of the record has an invalid value", errorType: customerror.BadRequest},
+	SerializationFailure: {msg: "the operation conflicted with a concurrent one, please try again", errorType: customerror.Conflict},
+	Deadlock:             {msg: "the operation conflicted with a concurrent one, please try again", errorType: customerror.Conflict},
+	ConnectionFailure:    {msg: "the database is unavailable at the moment", errorType: customerror.ServiceUnavailable},
+}
+
+// Error is the model of a database error translated from the error reported by the driver.
+// Its message is safe to be returned to clients, while the original error remains available through Unwrap.
+type Error struct {
+	Kind       ErrorKind
+	Constraint string
+	Column     string
+	Message    string
+	Err        error
+}
+
+// Error is the function that returns the safe message of the database error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap is the function that returns the error reported by the driver.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorType is the function that gets the type of the database error based on its kind.
+func (e *Error) ErrorType() customerror.ErrorType {
+	return errorKindDetails[e.Kind].errorType
+}
+
+// TranslateError is the function that translates the error reported by the postgres or mysql driver
+// into a database error. The errors that are not recognized are returned unchanged.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dbErr *Error
+	if errors.As(err, &dbErr) {
+		return err
+	}
+
+	kind, constraint, column := classifyError(err)
+	if kind == UnknownError {
+		return err
+	}
+
+	return &Error{
+		Kind:       kind,
+		Constraint: constraint,
+		Column:     column,
+		Message:    errorKindDetails[kind].msg,
+		Err:        err,
+	}
+}
+
+// TranslateErrorContext is the function that translates the error reported by the driver like TranslateError
+// and logs the original error through the logger stored in context, since its details are hidden from clients.
+func TranslateErrorContext(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	translatedErr := TranslateError(err)
+
+	level := slog.LevelWarn
+	if errorType := customerror.GetType(translatedErr); errorType == customerror.NoType || errorType == customerror.ServiceUnavailable {
+		level = slog.LevelError
+	}
+
+	loggerpkg.FromContext(ctx).Log(ctx, level, "database operation failed", slog.Any("error", err))
+
+	return translatedErr
+}
+
+// IsUniqueViolation is the function that checks if an error reports the violation of a unique constraint,
+// regardless of the database driver. When the constraint name is empty, any unique constraint matches.
+func IsUniqueViolation(err error, constraint string) bool {
+	var dbErr *Error
+	if !errors.As(TranslateError(err), &dbErr) {
+		return false
+	}
+
+	return dbErr.Kind == UniqueViolation && (constraint == "" || dbErr.Constraint == constraint)
+}
+
+// classifyError is the function that gets the kind of an error along with the violated constraint and column, if any.
+func classifyError(err error) (ErrorKind, string, string) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if kind, ok := postgresErrorKinds[pgErr.Code]; ok {
+			return kind, pgErr.ConstraintName, pgErr.ColumnName
+		}
+
+		// The class 08 gathers the connection exceptions.
+		if strings.HasPrefix(pgErr.Code, "08") {
+			return ConnectionFailure, "", ""
+		}
+
+		return UnknownError, "", ""
+	}
+
+	var mysqlErr *mysqldriver.MySQLError
+	if errors.As(err, &mysqlErr) {
+		kind, ok := mysqlErrorKinds[mysqlErr.Number]
+		if !ok {
+			return UnknownError, "", ""
+		}
+
+		switch kind {
+		case UniqueViolation:
+			return kind, mysqlDuplicateEntryKey(mysqlErr.Message), ""
+		case ForeignKeyViolation:
+			return kind, mysqlQuotedName(mysqlErr.Message, "CONSTRAINT `", "`"), ""
+		case NotNullViolation:
+			return kind, "", mysqlQuotedName(mysqlErr.Message, "Column '", "'")
+		case CheckViolation:
+			return kind, mysqlQuotedName(mysqlErr.Message, "Check constraint '", "'"), ""
+		}
+
+		return kind, "", ""
+	}
+
+	// The failures to reach the server are reported as network errors by both drivers.
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysqldriver.ErrInvalidConn) {
+		return ConnectionFailure, "", ""
+	}
+
+	// The generic gorm error is reported when the dialector translates the unique violation by itself,
+	// in which case the constraint name is lost.
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return UniqueViolation, "", ""
+	}
+
+	return UnknownError, "", ""
+}
+
+// mysqlDuplicateEntryKey is the function that extracts the key name from a message such as
+// "Duplicate entry 'x' for key 'logins.logins_user_id_key'", in which the table prefix is optional.
+func mysqlDuplicateEntryKey(message string) string {
+	key := mysqlQuotedName(message, "for key '", "'")
+
+	if dotIndex := strings.LastIndex(key, "."); dotIndex >= 0 {
+		key = key[dotIndex+1:]
+	}
+
+	return key
+}
+
+// mysqlQuotedName is the function that extracts the name enclosed between a prefix and a closing quote
+// from a mysql error message.
+func mysqlQuotedName(message string, prefix string, quote string) string {
+	index := strings.LastIndex(message, prefix)
+	if index < 0 {
+		return ""
+	}
+
+	name := message[index+len(prefix):]
+
+	if endIndex := strings.Index(name, quote); endIndex >= 0 {
+		name = name[:endIndex]
+	}
+
+	return name
+}
@@ -0,0 +1,54 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	"gorm.io/gorm"
+)
+
+// NewPingChecker is the function that builds the checker of the database connection.
+func NewPingChecker(db *gorm.DB) healthpkg.Checker {
+	return healthpkg.NewChecker("database", func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		return sqlDB.PingContext(ctx)
+	})
+}
+
+// NewSchemaChecker is the function that builds the checker of the database schema.
+// It makes sure the tables and the columns of the models exist, so that the migrations are known to be current.
+func NewSchemaChecker(db *gorm.DB, models ...interface{}) healthpkg.Checker {
+	return healthpkg.NewChecker("migrations", func(ctx context.Context) error {
+		tx := db.WithContext(ctx)
+
+		for _, model := range models {
+			stmt := &gorm.Statement{DB: tx}
+			if err := stmt.Parse(model); err != nil {
+				return err
+			}
+
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(model) {
+				return fmt.Errorf("the table %s does not exist", stmt.Schema.Table)
+			}
+
+			for _, field := range stmt.Schema.Fields {
+				if field.DBName == "" {
+					continue
+				}
+
+				if !migrator.HasColumn(model, field.DBName) {
+					return fmt.Errorf("the column %s of the table %s does not exist", field.DBName, stmt.Schema.Table)
+				}
+			}
+		}
+
+		return nil
+	})
+}
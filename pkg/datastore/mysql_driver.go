@@ -0,0 +1,165 @@
+package datastore
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const mysqlTLSConfigName = "datastore"
+
+type MySQLDriver struct {
+	Provider Provider
+}
+
+// NewMySQLDriver is the factory function that encapsulates the implementation related to mysql.
+func NewMySQLDriver(dbConfig map[string]string) (IDatastore, error) {
+	connConfig, err := NewConnectionConfig(dbConfig)
+	if err != nil {
+		return &MySQLDriver{}, err
+	}
+
+	dsn, err := MySQLDSN(dbConfig)
+	if err != nil {
+		return &MySQLDriver{}, err
+	}
+
+	dialector := mysql.Open(dsn)
+
+	db, err := connConfig.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return &MySQLDriver{}, customerror.Newf("failed to establish a database connection: %s", err.Error())
+	}
+
+	return &MySQLDriver{
+		Provider: Provider{
+			DB: db,
+		},
+	}, nil
+}
+
+// MySQLDSN is the function that builds the connection string from the database configuration,
+// unless a complete URL is informed.
+func MySQLDSN(dbConfig map[string]string) (string, error) {
+	if dbConfig["URL"] != "" {
+		return dbConfig["URL"], nil
+	}
+
+	config := mysqldriver.NewConfig()
+	config.User = dbConfig["USER"]
+	config.Passwd = dbConfig["PASSWORD"]
+	config.Net = "tcp"
+	config.Addr = fmt.Sprintf("%s:%s", dbConfig["HOST"], dbConfig["PORT"])
+	config.DBName = dbConfig["NAME"]
+	config.ParseTime = true
+	config.Params = map[string]string{"charset": "utf8mb4"}
+
+	tlsConfigName, err := registerMySQLTLSConfig(dbConfig)
+	if err != nil {
+		return "", err
+	}
+
+	config.TLSConfig = tlsConfigName
+
+	return config.FormatDSN(), nil
+}
+
+// registerMySQLTLSConfig is the function that translates the libpq-like SSL settings of the database configuration
+// into the TLS configuration of the mysql driver.
+func registerMySQLTLSConfig(dbConfig map[string]string) (string, error) {
+	switch dbConfig["SSL_MODE"] {
+	case "", "disable":
+		return "false", nil
+	case "require":
+		return "skip-verify", nil
+	case "verify-ca", "verify-full":
+	default:
+		return "", customerror.Newf("the database config SSL_MODE %s is not recognized", dbConfig["SSL_MODE"])
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if dbConfig["SSL_ROOT_CERT"] != "" {
+		rootCert, err := os.ReadFile(dbConfig["SSL_ROOT_CERT"])
+		if err != nil {
+			return "", customerror.Newf("failed to read the database root certificate: %s", err.Error())
+		}
+
+		rootCertPool := x509.NewCertPool()
+		if ok := rootCertPool.AppendCertsFromPEM(rootCert); !ok {
+			return "", customerror.New("failed to parse the database root certificate")
+		}
+
+		tlsConfig.RootCAs = rootCertPool
+	}
+
+	if dbConfig["SSL_CERT"] != "" {
+		clientCert, err := tls.LoadX509KeyPair(dbConfig["SSL_CERT"], dbConfig["SSL_KEY"])
+		if err != nil {
+			return "", customerror.Newf("failed to load the database client certificate: %s", err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	if dbConfig["SSL_MODE"] == "verify-full" {
+		tlsConfig.ServerName = dbConfig["HOST"]
+	} else {
+		// verify-ca checks the certificate chain but not the host name, as libpq does.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = verifyCertificateChain(tlsConfig)
+	}
+
+	if err := mysqldriver.RegisterTLSConfig(mysqlTLSConfigName, tlsConfig); err != nil {
+		return "", customerror.Newf("failed to register the database TLS config: %s", err.Error())
+	}
+
+	return mysqlTLSConfigName, nil
+}
+
+// verifyCertificateChain is the function that verifies the server certificate against the root certificates
+// without checking the host name.
+func verifyCertificateChain(tlsConfig *tls.Config) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+
+		if len(certs) == 0 {
+			return customerror.New("the database server did not present a certificate")
+		}
+
+		opts := x509.VerifyOptions{
+			Roots:         tlsConfig.RootCAs,
+			Intermediates: x509.NewCertPool(),
+		}
+
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+
+		_, err := certs[0].Verify(opts)
+		return err
+	}
+}
+
+// GetInstance is the function that gets the database instance.
+func (d *MySQLDriver) GetInstance() *gorm.DB {
+	return d.Provider.GetInstance()
+}
+
+// Close is the function that closes the database connection, releasing any open resources.
+func (d *MySQLDriver) Close() error {
+	return d.Provider.Close()
+}
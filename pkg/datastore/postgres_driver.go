@@ -1,47 +1,168 @@
 package datastore
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// postgresReplicationLagQuery returns zero when the replica has replayed everything it received,
+// so that an idle primary does not make a healthy replica look lagged.
+const postgresReplicationLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// PostgresDialector is the postgres dialector that keeps the errors reported by the driver as they are
+// instead of translating them to the generic gorm errors, so that the violated constraint can be recognized.
+type PostgresDialector struct {
+	postgres.Dialector
+}
+
+// NewPostgresDialector is the factory function that encapsulates the implementation related to postgres dialector.
+func NewPostgresDialector(config postgres.Config) gorm.Dialector {
+	return PostgresDialector{
+		Dialector: postgres.Dialector{Config: &config},
+	}
+}
+
+// Translate is the function that returns the error reported by the driver unchanged.
+func (d PostgresDialector) Translate(err error) error {
+	return err
+}
+
 type PostgresDriver struct {
 	Provider Provider
+	Replicas *ReplicaSet
 }
 
 // NewPostgresDriver is the factory function that encapsulates the implementation related to postgres.
 func NewPostgresDriver(dbConfig map[string]string) (IDatastore, error) {
-	dsn := ""
-
-	if dbConfig["URL"] != "" {
-		dsn = dbConfig["URL"]
-	} else {
-		dsn = fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
-			dbConfig["USER"],
-			dbConfig["PASSWORD"],
-			dbConfig["HOST"],
-			dbConfig["PORT"],
-			dbConfig["NAME"],
-		)
+	connConfig, err := NewConnectionConfig(dbConfig)
+	if err != nil {
+		return &PostgresDriver{}, err
 	}
 
-	dialector := postgres.Open(dsn)
+	dialector := NewPostgresDialector(postgres.Config{DSN: postgresDSN(dbConfig)})
 
-	db, err := gorm.Open(dialector, &gorm.Config{})
+	db, err := connConfig.Open(dialector, &gorm.Config{})
 	if err != nil {
 		return &PostgresDriver{}, customerror.Newf("failed to establish a database connection: %s", err.Error())
 	}
 
+	replicas, err := newPostgresReplicaSet(dbConfig, connConfig)
+	if err != nil {
+		return &PostgresDriver{}, err
+	}
+
+	if replicas != nil {
+		if err = db.Use(replicas); err != nil {
+			return &PostgresDriver{}, customerror.Newf("failed to register the database replicas: %s", err.Error())
+		}
+
+		replicas.Start()
+	}
+
 	return &PostgresDriver{
-		Provider{
+		Provider: Provider{
 			DB: db,
 		},
+		Replicas: replicas,
 	}, nil
 }
 
+// postgresDSN is the function that builds the connection string from the database configuration,
+// unless a complete URL is informed.
+func postgresDSN(dbConfig map[string]string) string {
+	if dbConfig["URL"] != "" {
+		return dbConfig["URL"]
+	}
+
+	sslMode := dbConfig["SSL_MODE"]
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+
+	dsn := fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s",
+		dbConfig["USER"],
+		dbConfig["PASSWORD"],
+		dbConfig["HOST"],
+		dbConfig["PORT"],
+		dbConfig["NAME"],
+		sslMode,
+	)
+
+	tlsParams := []struct {
+		key   string
+		param string
+	}{
+		{key: "SSL_ROOT_CERT", param: "sslrootcert"},
+		{key: "SSL_CERT", param: "sslcert"},
+		{key: "SSL_KEY", param: "sslkey"},
+	}
+
+	for _, tlsParam := range tlsParams {
+		if value := dbConfig[tlsParam.key]; value != "" {
+			dsn += fmt.Sprintf(" %s=%s", tlsParam.param, value)
+		}
+	}
+
+	return dsn
+}
+
+// newPostgresReplicaSet is the function that opens the connections to the replicas listed in the REPLICA_URLS
+// entry of the database configuration, if any.
+func newPostgresReplicaSet(dbConfig map[string]string, connConfig ConnectionConfig) (*ReplicaSet, error) {
+	if strings.TrimSpace(dbConfig["REPLICA_URLS"]) == "" {
+		return nil, nil
+	}
+
+	config := ReplicaConfig{
+		Policy:   dbConfig["REPLICA_POLICY"],
+		LagQuery: postgresReplicationLagQuery,
+	}
+
+	var err error
+
+	if config.MaxLag, err = parseDurationInSec(dbConfig, "REPLICA_MAX_LAG_IN_SEC"); err != nil {
+		return nil, err
+	}
+
+	if config.HealthCheckInterval, err = parseDurationInSec(dbConfig, "REPLICA_HEALTH_CHECK_INTERVAL_IN_SEC"); err != nil {
+		return nil, err
+	}
+
+	dbs := make([]*sql.DB, 0)
+
+	for _, replicaDSN := range strings.Split(dbConfig["REPLICA_URLS"], ",") {
+		replicaDSN = strings.TrimSpace(replicaDSN)
+		if replicaDSN == "" {
+			continue
+		}
+
+		// The automatic ping is disabled so that a replica that is down at startup is only kept out of rotation.
+		replicaDB, err := gorm.Open(postgres.Open(replicaDSN), &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			return nil, customerror.Newf("failed to open a database replica connection: %s", err.Error())
+		}
+
+		sqlDB, err := replicaDB.DB()
+		if err != nil {
+			return nil, customerror.Newf("failed to get a database replica connection: %s", err.Error())
+		}
+
+		connConfig.Apply(sqlDB)
+
+		dbs = append(dbs, sqlDB)
+	}
+
+	return NewReplicaSet(dbs, config)
+}
+
 // GetInstance is the function that gets the database instance.
 func (d *PostgresDriver) GetInstance() *gorm.DB {
 	return d.Provider.GetInstance()
@@ -49,5 +170,11 @@ func (d *PostgresDriver) GetInstance() *gorm.DB {
 
 // Close is the function that closes the database connection, releasing any open resources.
 func (d *PostgresDriver) Close() error {
+	if d.Replicas != nil {
+		if err := d.Replicas.Close(); err != nil {
+			return err
+		}
+	}
+
 	return d.Provider.Close()
 }
@@ -0,0 +1,244 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"gorm.io/gorm"
+)
+
+const (
+	// RoundRobinPolicy is the replica selection policy that rotates over the healthy replicas.
+	RoundRobinPolicy = "round_robin"
+	// LeastLatencyPolicy is the replica selection policy that picks the healthy replica with the lowest ping latency.
+	LeastLatencyPolicy = "least_latency"
+
+	usePrimaryKey = "datastore:use_primary"
+)
+
+// lockingClauseRegexp matches the locking clauses of Postgres and MySQL, which make a select need the primary.
+var lockingClauseRegexp = regexp.MustCompile(`(?i)\bFOR\s+(UPDATE|SHARE|NO\s+KEY\s+UPDATE|KEY\s+SHARE)\b|\bLOCK\s+IN\s+SHARE\s+MODE\b`)
+
+// ReplicaConfig is the model of the settings used to route read-only queries to replicas.
+type ReplicaConfig struct {
+	Policy              string
+	MaxLag              time.Duration
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	// LagQuery is the statement that returns the replication lag of a replica in seconds.
+	LagQuery string
+}
+
+type replica struct {
+	DB      *sql.DB
+	healthy atomic.Bool
+	latency atomic.Int64
+}
+
+// ReplicaSet is a gorm plugin that routes the queries performed outside a transaction to read replicas.
+type ReplicaSet struct {
+	Config   ReplicaConfig
+	replicas []*replica
+	next     atomic.Uint64
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+// NewReplicaSet is the factory function that encapsulates the implementation related to a set of read replicas.
+func NewReplicaSet(dbs []*sql.DB, config ReplicaConfig) (*ReplicaSet, error) {
+	switch config.Policy {
+	case "":
+		config.Policy = RoundRobinPolicy
+	case RoundRobinPolicy, LeastLatencyPolicy:
+	default:
+		return nil, customerror.Newf("replica selection policy %s is not recognized", config.Policy)
+	}
+
+	if config.HealthCheckTimeout == 0 {
+		config.HealthCheckTimeout = time.Second * 2
+	}
+
+	replicas := make([]*replica, 0, len(dbs))
+	for _, db := range dbs {
+		replicas = append(replicas, &replica{DB: db})
+	}
+
+	return &ReplicaSet{
+		Config:   config,
+		replicas: replicas,
+		stopChan: make(chan struct{}),
+	}, nil
+}
+
+// UsePrimary is the function that forces the queries performed with the returned instance to be sent to the primary.
+// It is useful when a read must observe a write that may not have been replicated yet. The instance is a new session,
+// so that it can perform several queries without their conditions piling up.
+func UsePrimary(db *gorm.DB) *gorm.DB {
+	return db.Set(usePrimaryKey, true).Session(&gorm.Session{})
+}
+
+// Name is the function that returns the name of the gorm plugin.
+func (rs *ReplicaSet) Name() string {
+	return "datastore:replica_set"
+}
+
+// Initialize is the function that registers the callback that switches read-only queries to a replica.
+// Only the query callback is switched, since the statements performed with Row, Rows, Scan or Exec from Raw
+// may write or lock rows, so they are always sent to the primary.
+func (rs *ReplicaSet) Initialize(db *gorm.DB) error {
+	return db.Callback().Query().Before("gorm:query").Register("datastore:replica_set_query", rs.switchConnPool)
+}
+
+func (rs *ReplicaSet) switchConnPool(db *gorm.DB) {
+	// Reads performed inside a transaction are pinned to the primary, as the transaction was opened on it.
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+
+	if usePrimary, ok := db.Get(usePrimaryKey); ok && usePrimary.(bool) {
+		return
+	}
+
+	if !isPlainSelect(db.Statement) {
+		return
+	}
+
+	if r := rs.Resolve(); r != nil {
+		db.Statement.ConnPool = r
+	}
+}
+
+// isPlainSelect is the function that evaluates if a statement only reads rows without locking them.
+// The statements built from raw SQL, such as the ones of Raw(...).Find, are evaluated from their text.
+func isPlainSelect(statement *gorm.Statement) bool {
+	if _, ok := statement.Clauses["FOR"]; ok {
+		return false
+	}
+
+	if statement.SQL.Len() == 0 {
+		return true
+	}
+
+	sql := strings.TrimSpace(statement.SQL.String())
+	if len(sql) < len("SELECT") || !strings.EqualFold(sql[:len("SELECT")], "SELECT") {
+		return false
+	}
+
+	return !lockingClauseRegexp.MatchString(sql)
+}
+
+// Resolve is the function that selects one of the healthy replicas based on the configured policy.
+// It returns nil when there is no healthy replica, so that the query falls back to the primary.
+func (rs *ReplicaSet) Resolve() *sql.DB {
+	healthy := make([]*replica, 0, len(rs.replicas))
+	for _, r := range rs.replicas {
+		if r.healthy.Load() {
+			healthy = append(healthy, r)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return nil
+	}
+
+	if rs.Config.Policy == LeastLatencyPolicy {
+		selected := healthy[0]
+		for _, r := range healthy[1:] {
+			if r.latency.Load() < selected.latency.Load() {
+				selected = r
+			}
+		}
+		return selected.DB
+	}
+
+	index := rs.next.Add(1) - 1
+	return healthy[index%uint64(len(healthy))].DB
+}
+
+// CheckHealth is the function that pings every replica and evaluates its replication lag,
+// taking a dead or lagging replica out of rotation until it recovers.
+func (rs *ReplicaSet) CheckHealth() {
+	var wg sync.WaitGroup
+
+	for _, r := range rs.replicas {
+		wg.Add(1)
+		go func(r *replica) {
+			defer wg.Done()
+			r.healthy.Store(rs.checkReplica(r) == nil)
+		}(r)
+	}
+
+	wg.Wait()
+}
+
+func (rs *ReplicaSet) checkReplica(r *replica) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.Config.HealthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := r.DB.PingContext(ctx); err != nil {
+		return err
+	}
+	r.latency.Store(int64(time.Since(start)))
+
+	if rs.Config.LagQuery == "" || rs.Config.MaxLag <= 0 {
+		return nil
+	}
+
+	var lagInSec float64
+	if err := r.DB.QueryRowContext(ctx, rs.Config.LagQuery).Scan(&lagInSec); err != nil {
+		return err
+	}
+
+	lag := time.Duration(lagInSec * float64(time.Second))
+	if lag > rs.Config.MaxLag {
+		return customerror.Newf("the replication lag of %s exceeds the limit of %s", lag, rs.Config.MaxLag)
+	}
+
+	return nil
+}
+
+// Start is the function that checks the replicas once and keeps checking them periodically in background.
+func (rs *ReplicaSet) Start() {
+	rs.CheckHealth()
+
+	if rs.Config.HealthCheckInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(rs.Config.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				rs.CheckHealth()
+			case <-rs.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// Close is the function that stops the health checks and closes the replica connections.
+func (rs *ReplicaSet) Close() error {
+	rs.stopOnce.Do(func() {
+		close(rs.stopChan)
+	})
+
+	var closeErr error
+	for _, r := range rs.replicas {
+		if err := r.DB.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
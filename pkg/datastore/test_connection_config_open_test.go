@@ -0,0 +1,77 @@
+package datastore_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestConnectionConfigOpen() {
+	var dialector gorm.Dialector
+	var mock sqlmock.Sqlmock
+
+	connConfig := datastorepkg.ConnectionConfig{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInOpeningTheDatabaseConnectionAndConfiguringItsPool",
+			SetUp: func(t *testing.T) {
+				sqlDB, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				mock = sqlMock
+				mock.ExpectPing()
+
+				dialector = postgres.New(postgres.Config{Conn: sqlDB})
+
+				connConfig = datastorepkg.ConnectionConfig{
+					MaxOpenConns: 7,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheDatabaseIsStillUnreachableWhenTheDeadlineIsReached",
+			SetUp: func(t *testing.T) {
+				mock = nil
+
+				dialector = postgres.Open("host=127.0.0.1 port=1 user=postgres dbname=db sslmode=disable connect_timeout=1")
+
+				connConfig = datastorepkg.ConnectionConfig{
+					ConnectTimeout:       time.Millisecond * 300,
+					ConnectRetryInterval: time.Millisecond * 100,
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			db, err := connConfig.Open(dialector, &gorm.Config{})
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				sqlDB, err := db.DB()
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, connConfig.MaxOpenConns, sqlDB.Stats().MaxOpenConnections)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost")
+				assert.Nil(t, db)
+			}
+
+			if mock != nil {
+				err = mock.ExpectationsWereMet()
+				assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+			}
+		})
+	}
+}
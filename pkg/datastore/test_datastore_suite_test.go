@@ -8,6 +8,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	envpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/env"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -56,6 +57,14 @@ func NewMockDB(driver string) (*gorm.DB, sqlmock.Sqlmock) {
 		if err != nil {
 			log.Panicf("%s: %s", errorMsg, err.Error())
 		}
+	case "mysql":
+		db, err = gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		if err != nil {
+			log.Panicf("%s: %s", errorMsg, err.Error())
+		}
 	}
 
 	if db == nil {
@@ -0,0 +1,97 @@
+package datastore_test
+
+import (
+	"errors"
+	"testing"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestIsUniqueViolation() {
+	var err error
+
+	constraint := ""
+
+	isUniqueViolation := false
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRecognizingAPostgresUniqueViolation",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23505", ConstraintName: "logins_user_id_key"}
+				constraint = "logins_user_id_key"
+				isUniqueViolation = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRecognizingAPostgresUniqueViolationOfAnyConstraint",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23505", ConstraintName: "users_username_key"}
+				constraint = ""
+				isUniqueViolation = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRecognizingAMySQLUniqueViolation",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'logins.logins_user_id_key'"}
+				constraint = "logins_user_id_key"
+				isUniqueViolation = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRecognizingAMySQLUniqueViolationWithoutTheTablePrefix",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'logins_user_id_key'"}
+				constraint = "logins_user_id_key"
+				isUniqueViolation = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRecognizingTheGormDuplicatedKeyError",
+			SetUp: func(t *testing.T) {
+				err = gorm.ErrDuplicatedKey
+				constraint = ""
+				isUniqueViolation = true
+			},
+		},
+		{
+			Context: "ItShouldFailIfTheViolatedConstraintIsAnotherOne",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23505", ConstraintName: "users_username_key"}
+				constraint = "logins_user_id_key"
+				isUniqueViolation = false
+			},
+		},
+		{
+			Context: "ItShouldFailIfTheErrorIsNotAUniqueViolation",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{Number: 1452, Message: "Cannot add or update a child row"}
+				constraint = ""
+				isUniqueViolation = false
+			},
+		},
+		{
+			Context: "ItShouldFailIfTheErrorIsNotReportedByTheDatabase",
+			SetUp: func(t *testing.T) {
+				err = errors.New("failed")
+				constraint = ""
+				isUniqueViolation = false
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedIsUniqueViolation := datastorepkg.IsUniqueViolation(err, constraint)
+
+			assert.Equal(t, isUniqueViolation, returnedIsUniqueViolation)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package datastore_test
+
+import (
+	"testing"
+
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestMySQLDriverNew() {
+	dbConfig := map[string]string{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldFailInCreatingAMySQLDriverIfTheConnectionSettingsAreInvalid",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"MAX_OPEN_CONNS": "testing",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailInCreatingAMySQLDriverIfTheSSLModeIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"SSL_MODE": "testing",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailInCreatingAMySQLDriverIfTheDatabaseIsUnreachable",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"USER": "user",
+					"HOST": "127.0.0.1",
+					"PORT": "1",
+					"NAME": "db",
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			driver, err := datastorepkg.NewMySQLDriver(dbConfig)
+
+			assert.Empty(t, driver)
+			assert.NotNil(t, err, "Predicted error lost")
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package datastore_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCertificate is the function that creates a self-signed certificate and writes it along with its key
+// to PEM files in the directory.
+func writeCertificate(t *testing.T, dir string, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	certFile := filepath.Join(dir, commonName+".crt")
+	err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	keyFile := filepath.Join(dir, commonName+".key")
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	return certFile, keyFile
+}
+
+func (ts *TestSuite) TestMySQLDSN() {
+	dir := ts.T().TempDir()
+
+	caCertFile, _ := writeCertificate(ts.T(), dir, "ca")
+	clientCertFile, clientKeyFile := writeCertificate(ts.T(), dir, "client")
+
+	invalidCertFile := filepath.Join(dir, "invalid.crt")
+	err := os.WriteFile(invalidCertFile, []byte("invalid"), 0600)
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	baseDBConfig := map[string]string{
+		"USER":     "user",
+		"PASSWORD": "password",
+		"HOST":     "mysqlhost",
+		"PORT":     "3306",
+		"NAME":     "db",
+	}
+
+	dbConfig := map[string]string{}
+
+	assertConfig := func(t *testing.T, config *mysqldriver.Config) {}
+
+	withSSL := func(sslConfig map[string]string) map[string]string {
+		config := make(map[string]string, len(baseDBConfig)+len(sslConfig))
+		for key, value := range baseDBConfig {
+			config[key] = value
+		}
+		for key, value := range sslConfig {
+			config[key] = value
+		}
+		return config
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInBuildingTheDSNFromTheDBConfig",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(nil)
+				assertConfig = func(t *testing.T, config *mysqldriver.Config) {
+					assert.Equal(t, "user", config.User)
+					assert.Equal(t, "password", config.Passwd)
+					assert.Equal(t, "tcp", config.Net)
+					assert.Equal(t, "mysqlhost:3306", config.Addr)
+					assert.Equal(t, "db", config.DBName)
+					assert.True(t, config.ParseTime)
+					assert.Equal(t, "utf8mb4", config.Params["charset"])
+					assert.Equal(t, "false", config.TLSConfig)
+					assert.Nil(t, config.TLS)
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInUsingTheDBConfigURL",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"URL": "user:password@tcp(urlhost:3307)/urldb",
+				}
+				assertConfig = func(t *testing.T, config *mysqldriver.Config) {
+					assert.Equal(t, "urlhost:3307", config.Addr)
+					assert.Equal(t, "urldb", config.DBName)
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInEncryptingTheConnectionWithoutVerifyingTheServerIfTheSSLModeIsRequire",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{"SSL_MODE": "require"})
+				assertConfig = func(t *testing.T, config *mysqldriver.Config) {
+					assert.Equal(t, "skip-verify", config.TLSConfig)
+					assert.True(t, config.TLS.InsecureSkipVerify)
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInVerifyingTheServerChainButNotItsHostNameIfTheSSLModeIsVerifyCA",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{"SSL_MODE": "verify-ca", "SSL_ROOT_CERT": caCertFile})
+				assertConfig = func(t *testing.T, config *mysqldriver.Config) {
+					assert.NotNil(t, config.TLS.RootCAs)
+					assert.True(t, config.TLS.InsecureSkipVerify)
+					assert.NotNil(t, config.TLS.VerifyPeerCertificate)
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInVerifyingTheServerChainAndItsHostNameIfTheSSLModeIsVerifyFull",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{
+					"SSL_MODE":      "verify-full",
+					"SSL_ROOT_CERT": caCertFile,
+					"SSL_CERT":      clientCertFile,
+					"SSL_KEY":       clientKeyFile,
+				})
+				assertConfig = func(t *testing.T, config *mysqldriver.Config) {
+					assert.NotNil(t, config.TLS.RootCAs)
+					assert.False(t, config.TLS.InsecureSkipVerify)
+					assert.Equal(t, "mysqlhost", config.TLS.ServerName)
+					assert.Len(t, config.TLS.Certificates, 1)
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheSSLModeIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{"SSL_MODE": "testing"})
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheRootCertificateCannotBeRead",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{"SSL_MODE": "verify-full", "SSL_ROOT_CERT": filepath.Join(dir, "missing.crt")})
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheRootCertificateCannotBeParsed",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{"SSL_MODE": "verify-full", "SSL_ROOT_CERT": invalidCertFile})
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheClientCertificateCannotBeLoaded",
+			SetUp: func(t *testing.T) {
+				dbConfig = withSSL(map[string]string{
+					"SSL_MODE": "verify-full",
+					"SSL_CERT": invalidCertFile,
+					"SSL_KEY":  clientKeyFile,
+				})
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			dsn, err := datastorepkg.MySQLDSN(dbConfig)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				config, err := mysqldriver.ParseDSN(dsn)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				assertConfig(t, config)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost")
+				assert.Empty(t, dsn)
+			}
+		})
+	}
+}
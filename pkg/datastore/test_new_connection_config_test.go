@@ -0,0 +1,77 @@
+package datastore_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNewConnectionConfig() {
+	dbConfig := map[string]string{}
+
+	connConfig := datastorepkg.ConnectionConfig{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCreatingAConnectionConfigWithDefaultValues",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{}
+
+				connConfig = datastorepkg.ConnectionConfig{
+					ConnectRetryInterval: time.Millisecond * 500,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCreatingAConnectionConfig",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"MAX_OPEN_CONNS":               "20",
+					"MAX_IDLE_CONNS":               "10",
+					"CONN_MAX_LIFETIME_IN_SEC":     "1800",
+					"CONN_MAX_IDLE_TIME_IN_SEC":    "300",
+					"CONNECT_TIMEOUT_IN_SEC":       "60",
+					"CONNECT_RETRY_INTERVAL_IN_MS": "250",
+				}
+
+				connConfig = datastorepkg.ConnectionConfig{
+					MaxOpenConns:         20,
+					MaxIdleConns:         10,
+					ConnMaxLifetime:      time.Minute * 30,
+					ConnMaxIdleTime:      time.Minute * 5,
+					ConnectTimeout:       time.Minute,
+					ConnectRetryInterval: time.Millisecond * 250,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfAnEntryOfTheDatabaseConfigIsNotAnInteger",
+			SetUp: func(t *testing.T) {
+				dbConfig = map[string]string{
+					"MAX_OPEN_CONNS": "testing",
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedConnConfig, err := datastorepkg.NewConnectionConfig(dbConfig)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, connConfig, returnedConnConfig)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost")
+			}
+		})
+	}
+}
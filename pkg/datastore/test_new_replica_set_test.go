@@ -0,0 +1,58 @@
+package datastore_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNewReplicaSet() {
+	config := datastorepkg.ReplicaConfig{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCreatingAReplicaSetWithTheDefaultPolicy",
+			SetUp: func(t *testing.T) {
+				config = datastorepkg.ReplicaConfig{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCreatingAReplicaSetWithTheLeastLatencyPolicy",
+			SetUp: func(t *testing.T) {
+				config = datastorepkg.ReplicaConfig{
+					Policy: datastorepkg.LeastLatencyPolicy,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheReplicaSelectionPolicyIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				config = datastorepkg.ReplicaConfig{
+					Policy: "testing",
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			replicaSet, err := datastorepkg.NewReplicaSet([]*sql.DB{}, config)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.NotNil(t, replicaSet)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost")
+				assert.Nil(t, replicaSet)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package datastore_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (ts *TestSuite) TestReplicaSetInitialize() {
+	driver := "postgres"
+
+	sqlQuery := `SELECT * FROM "users"`
+
+	var db *gorm.DB
+	var primaryMock, replicaMock sqlmock.Sqlmock
+	var query func(db *gorm.DB) error
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSendingAQueryPerformedOutsideATransactionToTheReplica",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					return db.Find(&persistententity.Users{}).Error
+				}
+
+				replicaMock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInPinningAQueryPerformedInsideATransactionToThePrimary",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					dbTrx := db.Begin()
+					if err := dbTrx.Find(&persistententity.Users{}).Error; err != nil {
+						return err
+					}
+					return dbTrx.Commit().Error
+				}
+
+				primaryMock.ExpectBegin()
+				primaryMock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+				primaryMock.ExpectCommit()
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingAQueryToThePrimaryWhenItIsForced",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					return datastorepkg.UsePrimary(db).Find(&persistententity.Users{}).Error
+				}
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingTheQueriesPerformedWithTheSameForcedInstanceToThePrimaryWithoutPilingUpTheirConditions",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					primaryDB := datastorepkg.UsePrimary(db)
+					if err := primaryDB.Where("username=?", "username").Find(&persistententity.Users{}).Error; err != nil {
+						return err
+					}
+					return primaryDB.Find(&persistententity.Users{}).Error
+				}
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE username=$1`)).
+					WithArgs("username").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+				primaryMock.ExpectQuery(regexp.QuoteMeta(sqlQuery) + "$").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingARawSelectPerformedOutsideATransactionToTheReplica",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					return db.Raw(sqlQuery).Find(&persistententity.Users{}).Error
+				}
+
+				replicaMock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingARawWriteScannedOutsideATransactionToThePrimary",
+			SetUp: func(t *testing.T) {
+				rawQuery := `INSERT INTO "users" ("username") VALUES ($1) RETURNING "id"`
+
+				query = func(db *gorm.DB) error {
+					var id string
+					return db.Raw(rawQuery, "username").Scan(&id).Error
+				}
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(rawQuery)).
+					WithArgs("username").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id"))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingARawLockingSelectPerformedOutsideATransactionToThePrimary",
+			SetUp: func(t *testing.T) {
+				rawQuery := `SELECT * FROM "users" FOR UPDATE`
+
+				query = func(db *gorm.DB) error {
+					return db.Raw(rawQuery).Find(&persistententity.Users{}).Error
+				}
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(rawQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSendingALockingQueryPerformedOutsideATransactionToThePrimary",
+			SetUp: func(t *testing.T) {
+				query = func(db *gorm.DB) error {
+					return db.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&persistententity.Users{}).Error
+				}
+
+				primaryMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" FOR UPDATE`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			db, primaryMock = NewMockDB(driver)
+
+			var replicaDB *sql.DB
+			replicaDB, replicaMock = NewMockReplica()
+
+			replicaSet, err := datastorepkg.NewReplicaSet([]*sql.DB{replicaDB}, datastorepkg.ReplicaConfig{})
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			err = db.Use(replicaSet)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			replicaMock.ExpectPing()
+			replicaSet.CheckHealth()
+
+			tc.SetUp(t)
+
+			err = query(db)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			err = primaryMock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+
+			err = replicaMock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
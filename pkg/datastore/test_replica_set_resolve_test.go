@@ -0,0 +1,113 @@
+package datastore_test
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func NewMockReplica() (*sql.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("failed to open a stub database replica connection: %s", err.Error())
+	}
+
+	return sqlDB, mock
+}
+
+func (ts *TestSuite) TestReplicaSetResolve() {
+	replicaDB1, replicaMock1 := NewMockReplica()
+	replicaDB2, replicaMock2 := NewMockReplica()
+
+	replicaSet := &datastorepkg.ReplicaSet{}
+
+	var expectedDBs []*sql.DB
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRotatingOverTheHealthyReplicas",
+			SetUp: func(t *testing.T) {
+				var err error
+				replicaSet, err = datastorepkg.NewReplicaSet([]*sql.DB{replicaDB1, replicaDB2}, datastorepkg.ReplicaConfig{})
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				replicaMock1.ExpectPing()
+				replicaMock2.ExpectPing()
+
+				expectedDBs = []*sql.DB{replicaDB1, replicaDB2, replicaDB1}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTakingADeadReplicaOutOfRotation",
+			SetUp: func(t *testing.T) {
+				var err error
+				replicaSet, err = datastorepkg.NewReplicaSet([]*sql.DB{replicaDB1, replicaDB2}, datastorepkg.ReplicaConfig{})
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				replicaMock1.ExpectPing().WillReturnError(customerror.New("failed"))
+				replicaMock2.ExpectPing()
+
+				expectedDBs = []*sql.DB{replicaDB2, replicaDB2}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTakingALaggingReplicaOutOfRotation",
+			SetUp: func(t *testing.T) {
+				var err error
+				replicaSet, err = datastorepkg.NewReplicaSet([]*sql.DB{replicaDB1, replicaDB2}, datastorepkg.ReplicaConfig{
+					MaxLag:   10 * time.Second,
+					LagQuery: "SELECT lag",
+				})
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				replicaMock1.ExpectPing()
+				replicaMock1.ExpectQuery("SELECT lag").WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(60.0))
+				replicaMock2.ExpectPing()
+				replicaMock2.ExpectQuery("SELECT lag").WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(0.5))
+
+				expectedDBs = []*sql.DB{replicaDB2}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInFallingBackToThePrimaryIfThereIsNoHealthyReplica",
+			SetUp: func(t *testing.T) {
+				var err error
+				replicaSet, err = datastorepkg.NewReplicaSet([]*sql.DB{replicaDB1}, datastorepkg.ReplicaConfig{})
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				replicaMock1.ExpectPing().WillReturnError(customerror.New("failed"))
+
+				expectedDBs = []*sql.DB{nil}
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			replicaSet.CheckHealth()
+
+			for _, expectedDB := range expectedDBs {
+				assert.Equal(t, expectedDB, replicaSet.Resolve())
+			}
+
+			err := replicaMock1.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+
+			err = replicaMock2.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
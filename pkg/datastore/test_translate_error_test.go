@@ -0,0 +1,158 @@
+package datastore_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestTranslateError() {
+	var err error
+
+	dbErr := &datastorepkg.Error{}
+
+	errorType := customerror.NoType
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresUniqueViolation",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23505", ConstraintName: "users_username_key", Message: "duplicate key value violates unique constraint"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.UniqueViolation, Constraint: "users_username_key"}
+				errorType = customerror.Conflict
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresForeignKeyViolation",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23503", ConstraintName: "logins_user_id_users_id_foreign"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.ForeignKeyViolation, Constraint: "logins_user_id_users_id_foreign"}
+				errorType = customerror.UnprocessableEntity
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresNotNullViolation",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23502", ColumnName: "username"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.NotNullViolation, Column: "username"}
+				errorType = customerror.BadRequest
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresCheckViolation",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "23514", ConstraintName: "users_username_check"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.CheckViolation, Constraint: "users_username_check"}
+				errorType = customerror.BadRequest
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresSerializationFailure",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "40001"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.SerializationFailure}
+				errorType = customerror.Conflict
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresDeadlock",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "40P01"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.Deadlock}
+				errorType = customerror.Conflict
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAPostgresConnectionException",
+			SetUp: func(t *testing.T) {
+				err = &pgconn.PgError{Code: "08006"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.ConnectionFailure}
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingANetworkError",
+			SetUp: func(t *testing.T) {
+				err = fmt.Errorf("failed to connect: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.ConnectionFailure}
+				errorType = customerror.ServiceUnavailable
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAMySQLForeignKeyViolation",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{
+					Number:  1452,
+					Message: "Cannot add or update a child row: a foreign key constraint fails (`db`.`logins`, CONSTRAINT `logins_user_id_users_id_foreign` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))",
+				}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.ForeignKeyViolation, Constraint: "logins_user_id_users_id_foreign"}
+				errorType = customerror.UnprocessableEntity
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAMySQLNotNullViolation",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{Number: 1048, Message: "Column 'username' cannot be null"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.NotNullViolation, Column: "username"}
+				errorType = customerror.BadRequest
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInTranslatingAMySQLDeadlock",
+			SetUp: func(t *testing.T) {
+				err = &mysqldriver.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}
+				dbErr = &datastorepkg.Error{Kind: datastorepkg.Deadlock}
+				errorType = customerror.Conflict
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheErrorIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				err = errors.New("failed")
+				errorType = customerror.NoType
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedErr := datastorepkg.TranslateError(err)
+
+			returnedDBErr := &datastorepkg.Error{}
+
+			if !tc.WantError {
+				assert.True(t, errors.As(returnedErr, &returnedDBErr), "The error was not translated")
+				assert.Equal(t, dbErr.Kind, returnedDBErr.Kind)
+				assert.Equal(t, dbErr.Constraint, returnedDBErr.Constraint)
+				assert.Equal(t, dbErr.Column, returnedDBErr.Column)
+				assert.NotContains(t, returnedErr.Error(), err.Error())
+				assert.Equal(t, err, errors.Unwrap(returnedErr))
+			} else {
+				assert.Equal(t, err, returnedErr)
+			}
+
+			assert.Equal(t, errorType, customerror.GetType(returnedErr))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package connection
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+const (
+	// CodeInvalidCursor is the code of the error returned when the after cursor was not issued by a connection.
+	CodeInvalidCursor = "INVALID_CURSOR"
+	// CodeInvalidPageSize is the code of the error returned when the first argument is out of the allowed range.
+	CodeInvalidPageSize = "INVALID_PAGE_SIZE"
+)
+
+// cursorPrefix is the prefix of the keys encoded by the cursors, which tells the cursors apart from arbitrary base64 strings.
+const cursorPrefix = "cursor:"
+
+// Args are the arguments of a connection field: the number of the items of the page and the cursor they come after.
+type Args struct {
+	First int
+	After string
+}
+
+// PageInfo is the information about the page of a connection.
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// Edge is an item of the page of a connection along with its cursor.
+type Edge struct {
+	Cursor string      `json:"cursor"`
+	Node   interface{} `json:"node"`
+}
+
+// Connection is the page of a list following the cursor connections specification, whose items are
+// requested with the first and after arguments.
+type Connection struct {
+	Edges    []Edge   `json:"edges"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// PageInfoType is the GraphQL type of PageInfo.
+var PageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "PageInfo",
+	Description: "The information about the page of a connection.",
+	Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"endCursor":   &graphql.Field{Type: graphql.String},
+	},
+})
+
+// NewType is the function that builds the GraphQL types of the connection and of the edge of a type of node,
+// named after it, such as UserConnection and UserEdge.
+func NewType(nodeType *graphql.Object) *graphql.Object {
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: nodeType.Name() + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(nodeType)},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: nodeType.Name() + "Connection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edgeType)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(PageInfoType)},
+		},
+	})
+}
+
+// Arguments is the function that returns the arguments of a connection field.
+func Arguments() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"first": &graphql.ArgumentConfig{Type: graphql.Int, Description: "The number of the items of the page."},
+		"after": &graphql.ArgumentConfig{Type: graphql.String, Description: "The cursor the items of the page come after."},
+	}
+}
+
+// ParseArgs is the function that parses the arguments of a connection field, decoding the after cursor into its key.
+// The page has the default size when the first argument is not informed, and it is rejected when it is out of the range
+// from zero to the max size. The cursor is rejected as well when its key is not valid according to validKey, if any,
+// so that a forged key, such as one that is not a UUID, does not reach the query of the page.
+func ParseArgs(args map[string]interface{}, defaultFirst int, maxFirst int, validKey func(key string) bool) (Args, error) {
+	parsedArgs := Args{First: defaultFirst}
+
+	if first, ok := args["first"].(int); ok {
+		if first < 0 || first > maxFirst {
+			return Args{}, customerror.WithDetails(customerror.WithCode(customerror.BadRequest.Newf("the first argument must be between 0 and %d", maxFirst), CodeInvalidPageSize), "max_first", maxFirst)
+		}
+
+		parsedArgs.First = first
+	}
+
+	if after, ok := args["after"].(string); ok && after != "" {
+		key, err := DecodeCursor(after)
+		if err != nil {
+			return Args{}, err
+		}
+
+		if validKey != nil && !validKey(key) {
+			return Args{}, customerror.WithCode(customerror.BadRequest.Newf("the cursor %s is not valid", after), CodeInvalidCursor)
+		}
+
+		parsedArgs.After = key
+	}
+
+	return parsedArgs, nil
+}
+
+// EncodeCursor is the function that encodes the key of an item into an opaque cursor.
+func EncodeCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + key))
+}
+
+// DecodeCursor is the function that decodes a cursor into the key of its item.
+func DecodeCursor(cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return "", customerror.WithCode(customerror.BadRequest.Newf("the cursor %s is not valid", cursor), CodeInvalidCursor)
+	}
+
+	return strings.TrimPrefix(string(decoded), cursorPrefix), nil
+}
+
+// New is the factory function that encapsulates the implementation related to connection.
+// The nodes are the ones fetched for the page along with the next one, if any, which only tells that there is a next page,
+// so that the page is fetched with a single query of the first plus one nodes.
+func New[T any](nodes []T, first int, key func(T) string) Connection {
+	connection := Connection{Edges: make([]Edge, 0, len(nodes))}
+
+	if len(nodes) > first {
+		nodes = nodes[:first]
+		connection.PageInfo.HasNextPage = true
+	}
+
+	for _, node := range nodes {
+		connection.Edges = append(connection.Edges, Edge{Cursor: EncodeCursor(key(node)), Node: node})
+	}
+
+	if len(connection.Edges) > 0 {
+		endCursor := connection.Edges[len(connection.Edges)-1].Cursor
+		connection.PageInfo.EndCursor = &endCursor
+	}
+
+	return connection
+}
+
+// Paginate is the function that builds the connection of a page of nodes already in memory, sorted by their keys,
+// taking the first nodes whose keys come after the one of the after cursor.
+func Paginate[T any](nodes []T, args Args, key func(T) string) Connection {
+	start := 0
+
+	if args.After != "" {
+		for start < len(nodes) && key(nodes[start]) <= args.After {
+			start++
+		}
+	}
+
+	end := start + args.First + 1
+	if end > len(nodes) {
+		end = len(nodes)
+	}
+
+	return New(nodes[start:end], args.First, key)
+}
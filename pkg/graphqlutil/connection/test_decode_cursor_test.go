@@ -0,0 +1,62 @@
+package connection_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestDecodeCursor() {
+	cursor := ""
+
+	key := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInDecodingACursorIntoTheKeyItEncodes",
+			SetUp: func(t *testing.T) {
+				key = "4a4e9c38-7f4f-4e5a-9d0b-0c6b1c7f3e21"
+				cursor = connectiongraphqlutilpkg.EncodeCursor(key)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheCursorIsNotBase64",
+			SetUp: func(t *testing.T) {
+				cursor = "not a cursor"
+				key = ""
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheCursorWasNotIssuedByAConnection",
+			SetUp: func(t *testing.T) {
+				cursor = base64.RawURLEncoding.EncodeToString([]byte("4a4e9c38-7f4f-4e5a-9d0b-0c6b1c7f3e21"))
+				key = ""
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedKey, err := connectiongraphqlutilpkg.DecodeCursor(cursor)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, customerror.BadRequest, customerror.GetType(err))
+				assert.Equal(t, connectiongraphqlutilpkg.CodeInvalidCursor, customerror.GetCode(err))
+			}
+
+			assert.Equal(t, key, returnedKey)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package connection_test
+
+import (
+	"testing"
+
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNew() {
+	key := func(node string) string { return node }
+
+	nodes := make([]string, 0)
+
+	first := 0
+
+	connection := connectiongraphqlutilpkg.Connection{}
+
+	endCursor := func(key string) *string {
+		cursor := connectiongraphqlutilpkg.EncodeCursor(key)
+		return &cursor
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInBuildingAConnectionWithANextPageFromTheNodesFetchedBeyondTheFirstOnes",
+			SetUp: func(t *testing.T) {
+				nodes = []string{"a", "b", "c"}
+				first = 2
+				connection = connectiongraphqlutilpkg.Connection{
+					Edges: []connectiongraphqlutilpkg.Edge{
+						{Cursor: connectiongraphqlutilpkg.EncodeCursor("a"), Node: "a"},
+						{Cursor: connectiongraphqlutilpkg.EncodeCursor("b"), Node: "b"},
+					},
+					PageInfo: connectiongraphqlutilpkg.PageInfo{HasNextPage: true, EndCursor: endCursor("b")},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInBuildingAConnectionOfTheLastPage",
+			SetUp: func(t *testing.T) {
+				nodes = []string{"a"}
+				first = 2
+				connection = connectiongraphqlutilpkg.Connection{
+					Edges: []connectiongraphqlutilpkg.Edge{
+						{Cursor: connectiongraphqlutilpkg.EncodeCursor("a"), Node: "a"},
+					},
+					PageInfo: connectiongraphqlutilpkg.PageInfo{HasNextPage: false, EndCursor: endCursor("a")},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInBuildingAnEmptyConnection",
+			SetUp: func(t *testing.T) {
+				nodes = []string{}
+				first = 2
+				connection = connectiongraphqlutilpkg.Connection{
+					Edges: []connectiongraphqlutilpkg.Edge{},
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedConnection := connectiongraphqlutilpkg.New(nodes, first, key)
+
+			assert.Equal(t, connection, returnedConnection)
+		})
+	}
+}
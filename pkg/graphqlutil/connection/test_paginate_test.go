@@ -0,0 +1,71 @@
+package connection_test
+
+import (
+	"testing"
+
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestPaginate() {
+	key := func(node string) string { return node }
+
+	nodes := []string{"a", "b", "c", "d"}
+
+	args := connectiongraphqlutilpkg.Args{}
+
+	keys := make([]string, 0)
+
+	hasNextPage := false
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInPaginatingTheFirstNodes",
+			SetUp: func(t *testing.T) {
+				args = connectiongraphqlutilpkg.Args{First: 2}
+				keys = []string{"a", "b"}
+				hasNextPage = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInPaginatingTheNodesAfterACursor",
+			SetUp: func(t *testing.T) {
+				args = connectiongraphqlutilpkg.Args{First: 2, After: "b"}
+				keys = []string{"c", "d"}
+				hasNextPage = false
+			},
+		},
+		{
+			Context: "ItShouldSucceedInPaginatingTheNodesAfterTheKeyOfANodeThatNoLongerExists",
+			SetUp: func(t *testing.T) {
+				args = connectiongraphqlutilpkg.Args{First: 5, After: "bb"}
+				keys = []string{"c", "d"}
+				hasNextPage = false
+			},
+		},
+		{
+			Context: "ItShouldSucceedInPaginatingNoNodesAfterTheLastOne",
+			SetUp: func(t *testing.T) {
+				args = connectiongraphqlutilpkg.Args{First: 2, After: "d"}
+				keys = []string{}
+				hasNextPage = false
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedConnection := connectiongraphqlutilpkg.Paginate(nodes, args, key)
+
+			returnedKeys := make([]string, 0)
+			for _, edge := range returnedConnection.Edges {
+				returnedKeys = append(returnedKeys, edge.Node.(string))
+			}
+
+			assert.Equal(t, keys, returnedKeys)
+			assert.Equal(t, hasNextPage, returnedConnection.PageInfo.HasNextPage)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package connection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	connectiongraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/connection"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestParseArgs() {
+	defaultFirst := 20
+
+	maxFirst := 100
+
+	args := make(map[string]interface{})
+
+	var validKey func(key string) bool
+
+	parsedArgs := connectiongraphqlutilpkg.Args{}
+
+	errorCode := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInParsingTheArguments",
+			SetUp: func(t *testing.T) {
+				validKey = nil
+				args = map[string]interface{}{"first": 5, "after": connectiongraphqlutilpkg.EncodeCursor("key")}
+				parsedArgs = connectiongraphqlutilpkg.Args{First: 5, After: "key"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingTheArgumentsThatAreNotInformedIntoTheirDefaults",
+			SetUp: func(t *testing.T) {
+				validKey = nil
+				args = map[string]interface{}{}
+				parsedArgs = connectiongraphqlutilpkg.Args{First: defaultFirst}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheFirstArgumentIsNegative",
+			SetUp: func(t *testing.T) {
+				validKey = nil
+				args = map[string]interface{}{"first": -1}
+				parsedArgs = connectiongraphqlutilpkg.Args{}
+				errorCode = connectiongraphqlutilpkg.CodeInvalidPageSize
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheFirstArgumentExceedsTheMaxPageSize",
+			SetUp: func(t *testing.T) {
+				validKey = nil
+				args = map[string]interface{}{"first": maxFirst + 1}
+				parsedArgs = connectiongraphqlutilpkg.Args{}
+				errorCode = connectiongraphqlutilpkg.CodeInvalidPageSize
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheAfterArgumentIsNotAValidCursor",
+			SetUp: func(t *testing.T) {
+				validKey = nil
+				args = map[string]interface{}{"after": "key"}
+				parsedArgs = connectiongraphqlutilpkg.Args{}
+				errorCode = connectiongraphqlutilpkg.CodeInvalidCursor
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldSucceedInParsingTheAfterArgumentWhoseKeyIsValid",
+			SetUp: func(t *testing.T) {
+				validKey = func(key string) bool { return key == "key" }
+				args = map[string]interface{}{"after": connectiongraphqlutilpkg.EncodeCursor("key")}
+				parsedArgs = connectiongraphqlutilpkg.Args{First: defaultFirst, After: "key"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheKeyOfTheAfterArgumentIsNotValid",
+			SetUp: func(t *testing.T) {
+				validKey = func(key string) bool { return key == "key" }
+				args = map[string]interface{}{"after": connectiongraphqlutilpkg.EncodeCursor("foo")}
+				parsedArgs = connectiongraphqlutilpkg.Args{}
+				errorCode = connectiongraphqlutilpkg.CodeInvalidCursor
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedArgs, err := connectiongraphqlutilpkg.ParseArgs(args, defaultFirst, maxFirst, validKey)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, customerror.BadRequest, customerror.GetType(err))
+				assert.Equal(t, errorCode, customerror.GetCode(err))
+			}
+
+			assert.Equal(t, parsedArgs, returnedArgs)
+		})
+	}
+}
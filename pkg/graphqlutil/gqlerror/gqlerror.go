@@ -0,0 +1,79 @@
+package gqlerror
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+)
+
+// Error is the error reported in the errors of a GraphQL response, whose code and details are carried by its extensions,
+// as they are by the problems of the error responses of the API.
+type Error struct {
+	Message string
+	Code    string
+	Details map[string]interface{}
+}
+
+// New is the factory function that encapsulates the implementation related to GraphQL error.
+// Only the public message of the error is reported, while the internal one and its stack are logged for the server errors.
+func New(ctx context.Context, err error) *Error {
+	code := responsehttputilpkg.ErrorCode(err)
+
+	if customerror.GetType(err) == customerror.NoType {
+		loggerpkg.FromContext(ctx).Error("request failed", slog.Any("error", err), slog.String("stack", customerror.GetStack(err)))
+	}
+
+	return &Error{
+		Message: customerror.GetPublicMessage(err),
+		Code:    code,
+		Details: customerror.GetDetails(err),
+	}
+}
+
+// Error is the function that returns the message of the error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions is the function that returns the extensions of the error, which the GraphQL library adds to the response.
+func (e *Error) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"code": e.Code,
+	}
+
+	if len(e.Details) > 0 {
+		extensions["details"] = e.Details
+	}
+
+	return extensions
+}
+
+// Extensions is the function that gets the extensions of an error of a GraphQL response.
+// The library keeps the extensions of the errors returned by the resolvers, but not of the ones returned by their thunks,
+// which it wraps, so they are searched for down the wrapped errors.
+func Extensions(formattedError gqlerrors.FormattedError) map[string]interface{} {
+	if formattedError.Extensions != nil {
+		return formattedError.Extensions
+	}
+
+	err := formattedError.OriginalError()
+
+	for err != nil {
+		switch e := err.(type) {
+		case gqlerrors.ExtendedError:
+			return e.Extensions()
+		case gqlerrors.FormattedError:
+			err = e.OriginalError()
+		case *gqlerrors.Error:
+			err = e.OriginalError
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package gqlerror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	gqlerrorgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/gqlerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestExtensions() {
+	gqlError := &gqlerrorgraphqlutilpkg.Error{Message: "the user was not found", Code: "NOT_FOUND", Details: map[string]interface{}{"id": "1"}}
+
+	formattedError := gqlerrors.FormattedError{}
+
+	var extensions map[string]interface{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheExtensionsOfTheFormattedError",
+			SetUp: func(t *testing.T) {
+				formattedError = gqlerrors.FormattedError{Message: "failed", Extensions: map[string]interface{}{"code": "FAILED"}}
+				extensions = map[string]interface{}{"code": "FAILED"}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheExtensionsOfAnErrorWrappedByTheLibrary",
+			SetUp: func(t *testing.T) {
+				located := gqlerrors.NewErrorWithPath(gqlError.Message, nil, "", nil, nil, nil, gqlerrors.FormatError(gqlError))
+				formattedError = gqlerrors.FormatError(located)
+				extensions = map[string]interface{}{"code": "NOT_FOUND", "details": map[string]interface{}{"id": "1"}}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingNoExtensionsOfAnErrorWithoutThem",
+			SetUp: func(t *testing.T) {
+				formattedError = gqlerrors.FormatError(errors.New("Cannot query field \"unknown\" on type \"User\"."))
+				extensions = nil
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedExtensions := gqlerrorgraphqlutilpkg.Extensions(formattedError)
+
+			assert.Equal(t, extensions, returnedExtensions)
+		})
+	}
+}
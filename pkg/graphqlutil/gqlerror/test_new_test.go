@@ -0,0 +1,58 @@
+package gqlerror_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	gqlerrorgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/gqlerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNew() {
+	ctx := context.Background()
+
+	var err error
+
+	gqlError := &gqlerrorgraphqlutilpkg.Error{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInBuildingTheErrorOfAClientError",
+			SetUp: func(t *testing.T) {
+				err = customerror.WithDetails(customerror.WithCode(customerror.BadRequest.New("the cursor is not valid"), "INVALID_CURSOR"), "cursor", "abc")
+				gqlError = &gqlerrorgraphqlutilpkg.Error{
+					Message: "the cursor is not valid",
+					Code:    "INVALID_CURSOR",
+					Details: map[string]interface{}{"cursor": "abc"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInBuildingTheErrorOfAnInternalErrorWithoutRevealingItsMessage",
+			SetUp: func(t *testing.T) {
+				err = customerror.New("pq: relation \"users\" does not exist")
+				gqlError = &gqlerrorgraphqlutilpkg.Error{
+					Message: customerror.GetPublicMessage(err),
+					Code:    "INTERNAL_ERROR",
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedError := gqlerrorgraphqlutilpkg.New(ctx, err)
+
+			assert.Equal(t, gqlError.Message, returnedError.Message)
+			assert.Equal(t, gqlError.Code, returnedError.Code)
+			assert.Equal(t, len(gqlError.Details), len(returnedError.Details))
+
+			for key, value := range gqlError.Details {
+				assert.Equal(t, value, returnedError.Details[key])
+			}
+		})
+	}
+}
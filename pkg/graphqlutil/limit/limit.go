@@ -0,0 +1,220 @@
+package limit
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+const (
+	// CodeQueryTooDeep is the code of the error returned when the query nests its fields deeper than allowed.
+	CodeQueryTooDeep = "QUERY_TOO_DEEP"
+	// CodeQueryTooComplex is the code of the error returned when the query would resolve more fields than allowed.
+	CodeQueryTooComplex = "QUERY_TOO_COMPLEX"
+)
+
+// listSizeArgument is the argument of the fields that return lists which sets their size, as in the connections.
+const listSizeArgument = "first"
+
+// Limits are the limits of the queries, which are checked before they are executed.
+type Limits struct {
+	// MaxDepth is the max number of nested levels of fields. The depth is not limited if it is zero.
+	MaxDepth int
+	// MaxComplexity is the max number of fields the query resolves. The complexity is not limited if it is zero.
+	MaxComplexity int
+	// DefaultListSize is the size of the lists whose fields do not inform the first argument.
+	DefaultListSize int
+}
+
+// Cost is the cost of a query.
+type Cost struct {
+	// Depth is the number of nested levels of fields of the query.
+	Depth int
+	// Complexity is the number of fields the query resolves, in which the fields selected from a list with the first
+	// argument count once for each of its items.
+	Complexity int
+}
+
+// Analyze is the function that computes the cost of the operation of a document, which is the one with the given name
+// or the only one if the name is empty. The introspection fields, such as __schema, are not counted.
+// The document is expected to be valid against the schema, from which the fields that return lists are known.
+func Analyze(schema *graphql.Schema, document *ast.Document, operationName string, variables map[string]interface{}, defaultListSize int) Cost {
+	analyzer := analyzer{
+		schema:          schema,
+		fragments:       make(map[string]*ast.FragmentDefinition),
+		variables:       variables,
+		defaultListSize: defaultListSize,
+	}
+
+	var operation *ast.OperationDefinition
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		case *ast.FragmentDefinition:
+			analyzer.fragments[definition.Name.Value] = definition
+		}
+	}
+
+	if operation == nil {
+		return Cost{}
+	}
+
+	rootType := schema.QueryType()
+
+	switch operation.Operation {
+	case ast.OperationTypeMutation:
+		rootType = schema.MutationType()
+	case ast.OperationTypeSubscription:
+		rootType = schema.SubscriptionType()
+	}
+
+	if rootType == nil {
+		return Cost{}
+	}
+
+	return analyzer.selectionSet(operation.SelectionSet, rootType, make(map[string]bool))
+}
+
+// Check is the function that checks that the cost of the operation of a document is within the limits.
+func Check(schema *graphql.Schema, document *ast.Document, operationName string, variables map[string]interface{}, limits Limits) error {
+	cost := Analyze(schema, document, operationName, variables, limits.DefaultListSize)
+
+	if limits.MaxDepth > 0 && cost.Depth > limits.MaxDepth {
+		err := customerror.BadRequest.Newf("the query has a depth of %d, which exceeds the limit of %d", cost.Depth, limits.MaxDepth)
+		return customerror.WithDetails(customerror.WithCode(err, CodeQueryTooDeep), "depth", cost.Depth, "max_depth", limits.MaxDepth)
+	}
+
+	if limits.MaxComplexity > 0 && cost.Complexity > limits.MaxComplexity {
+		err := customerror.BadRequest.Newf("the query has a complexity of %d, which exceeds the limit of %d", cost.Complexity, limits.MaxComplexity)
+		return customerror.WithDetails(customerror.WithCode(err, CodeQueryTooComplex), "complexity", cost.Complexity, "max_complexity", limits.MaxComplexity)
+	}
+
+	return nil
+}
+
+type analyzer struct {
+	schema          *graphql.Schema
+	fragments       map[string]*ast.FragmentDefinition
+	variables       map[string]interface{}
+	defaultListSize int
+}
+
+// selectionSet is the function that computes the cost of a selection set of a type, in which the fragments are expanded.
+// The fragments being expanded are tracked, so that a cycle of fragments, which the validation rejects, does not loop.
+func (a analyzer) selectionSet(selectionSet *ast.SelectionSet, parentType graphql.Named, expanding map[string]bool) Cost {
+	cost := Cost{}
+
+	if selectionSet == nil {
+		return cost
+	}
+
+	for _, selection := range selectionSet.Selections {
+		var selectionCost Cost
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+
+			fieldDefinition := fieldsOf(parentType)[selection.Name.Value]
+			if fieldDefinition == nil {
+				continue
+			}
+
+			childCost := a.selectionSet(selection.SelectionSet, graphql.GetNamed(fieldDefinition.Type), expanding)
+			selectionCost = Cost{
+				Depth:      childCost.Depth + 1,
+				Complexity: 1 + a.listSize(selection, fieldDefinition)*childCost.Complexity,
+			}
+		case *ast.InlineFragment:
+			selectionCost = a.selectionSet(selection.SelectionSet, a.typeCondition(selection.TypeCondition, parentType), expanding)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+
+			fragment, ok := a.fragments[name]
+			if !ok || expanding[name] {
+				continue
+			}
+
+			expanding[name] = true
+			selectionCost = a.selectionSet(fragment.SelectionSet, a.typeCondition(fragment.TypeCondition, parentType), expanding)
+			delete(expanding, name)
+		}
+
+		if selectionCost.Depth > cost.Depth {
+			cost.Depth = selectionCost.Depth
+		}
+
+		cost.Complexity += selectionCost.Complexity
+	}
+
+	return cost
+}
+
+// typeCondition is the function that gets the type a fragment applies to, which is the parent one if it has no condition.
+func (a analyzer) typeCondition(typeCondition *ast.Named, parentType graphql.Named) graphql.Named {
+	if typeCondition == nil || typeCondition.Name == nil {
+		return parentType
+	}
+
+	return a.schema.Type(typeCondition.Name.Value)
+}
+
+// listSize is the function that gets the number of items a field returns from its first argument, which is a literal
+// or a variable. A field that has the argument but does not inform it returns the default number of items,
+// while a field that does not have it returns a single one.
+func (a analyzer) listSize(field *ast.Field, fieldDefinition *graphql.FieldDefinition) int {
+	hasListSizeArgument := false
+
+	for _, argument := range fieldDefinition.Args {
+		if argument.Name() == listSizeArgument {
+			hasListSizeArgument = true
+		}
+	}
+
+	if !hasListSizeArgument {
+		return 1
+	}
+
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != listSizeArgument {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(value.Value); err == nil {
+				return size
+			}
+		case *ast.Variable:
+			switch size := a.variables[value.Name.Value].(type) {
+			case int:
+				return size
+			case float64:
+				return int(size)
+			}
+		}
+	}
+
+	return a.defaultListSize
+}
+
+// fieldsOf is the function that gets the fields of a type, which only the objects and the interfaces have.
+func fieldsOf(t graphql.Named) graphql.FieldDefinitionMap {
+	switch t := t.(type) {
+	case *graphql.Object:
+		return t.Fields()
+	case *graphql.Interface:
+		return t.Fields()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package limit_test
+
+import (
+	"testing"
+
+	limitgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/limit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestAnalyze() {
+	schema := NewSchema(ts.T())
+
+	defaultListSize := 10
+
+	query := ""
+
+	operationName := ""
+
+	variables := make(map[string]interface{})
+
+	cost := limitgraphqlutilpkg.Cost{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAnalyzingTheCostOfAQueryOfSingleFields",
+			SetUp: func(t *testing.T) {
+				query = `{ item { id } }`
+				cost = limitgraphqlutilpkg.Cost{Depth: 2, Complexity: 2}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAnalyzingTheCostOfAQueryOfListsSizedByTheFirstArgument",
+			SetUp: func(t *testing.T) {
+				query = `{ items(first: 5) { id children(first: 2) { id } } }`
+				// items: 1 + 5 * (id: 1 + children: 1 + 2 * 1)
+				cost = limitgraphqlutilpkg.Cost{Depth: 3, Complexity: 21}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAnalyzingTheCostOfAQueryOfListsSizedByVariablesOrByDefault",
+			SetUp: func(t *testing.T) {
+				query = `query Items($first: Int) { items(first: $first) { children { id } } }`
+				variables = map[string]interface{}{"first": float64(3)}
+				// items: 1 + 3 * (children: 1 + 10 * 1)
+				cost = limitgraphqlutilpkg.Cost{Depth: 3, Complexity: 34}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAnalyzingTheCostOfAQueryWithFragments",
+			SetUp: func(t *testing.T) {
+				query = `{ item { ...Fields ... on Item { children(first: 1) { id } } } } fragment Fields on Item { id }`
+				variables = map[string]interface{}{}
+				cost = limitgraphqlutilpkg.Cost{Depth: 3, Complexity: 4}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAnalyzingTheCostOfTheNamedOperationWithoutTheIntrospectionFields",
+			SetUp: func(t *testing.T) {
+				query = `query A { item { id } } query B { __typename items(first: 2) { id } }`
+				operationName = "B"
+				cost = limitgraphqlutilpkg.Cost{Depth: 2, Complexity: 3}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedCost := limitgraphqlutilpkg.Analyze(schema, Parse(t, query), operationName, variables, defaultListSize)
+
+			assert.Equal(t, cost, returnedCost)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package limit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	limitgraphqlutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/graphqlutil/limit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestCheck() {
+	schema := NewSchema(ts.T())
+
+	query := ""
+
+	limits := limitgraphqlutilpkg.Limits{}
+
+	errorCode := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCheckingAQueryWithinTheLimits",
+			SetUp: func(t *testing.T) {
+				query = `{ items(first: 5) { children(first: 2) { id } } }`
+				limits = limitgraphqlutilpkg.Limits{MaxDepth: 3, MaxComplexity: 16, DefaultListSize: 10}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCheckingAQueryIfTheLimitsAreDisabled",
+			SetUp: func(t *testing.T) {
+				query = `{ items(first: 100) { children(first: 100) { children(first: 100) { id } } } }`
+				limits = limitgraphqlutilpkg.Limits{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsTooDeep",
+			SetUp: func(t *testing.T) {
+				query = `{ item { children { children { id } } } }`
+				limits = limitgraphqlutilpkg.Limits{MaxDepth: 3, MaxComplexity: 1000, DefaultListSize: 10}
+				errorCode = limitgraphqlutilpkg.CodeQueryTooDeep
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheQueryIsTooComplex",
+			SetUp: func(t *testing.T) {
+				query = `{ items(first: 5) { children(first: 2) { id } } }`
+				limits = limitgraphqlutilpkg.Limits{MaxDepth: 3, MaxComplexity: 15, DefaultListSize: 10}
+				errorCode = limitgraphqlutilpkg.CodeQueryTooComplex
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			err := limitgraphqlutilpkg.Check(schema, Parse(t, query), "", nil, limits)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, customerror.BadRequest, customerror.GetType(err))
+				assert.Equal(t, errorCode, customerror.GetCode(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package limit_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type Case struct {
+	Context   string
+	SetUp     func(t *testing.T)
+	WantError bool
+	TearDown  func(t *testing.T)
+}
+
+type Cases []Case
+
+type TestSuite struct {
+	suite.Suite
+	Cases Cases
+}
+
+func TestLimitSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// NewSchema is the function that builds a schema of items, whose children are a list sized by the first argument.
+func NewSchema(t *testing.T) *graphql.Schema {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.ID},
+		},
+	})
+
+	itemType.AddFieldConfig("children", &graphql.Field{
+		Type: graphql.NewList(itemType),
+		Args: graphql.FieldConfigArgument{"first": &graphql.ArgumentConfig{Type: graphql.Int}},
+	})
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"item": &graphql.Field{Type: itemType},
+				"items": &graphql.Field{
+					Type: graphql.NewList(itemType),
+					Args: graphql.FieldConfigArgument{"first": &graphql.ArgumentConfig{Type: graphql.Int}},
+				},
+			},
+		}),
+	})
+	assert.Nil(t, err)
+
+	return &schema
+}
+
+// Parse is the function that parses a query.
+func Parse(t *testing.T, query string) *ast.Document {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	assert.Nil(t, err)
+
+	return document
+}
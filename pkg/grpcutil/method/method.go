@@ -0,0 +1,68 @@
+package method
+
+import (
+	"strings"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"google.golang.org/grpc"
+)
+
+// Method is the model of a gRPC method.
+// The requirements of the method are declared by its fields, from which the interceptors decide what to apply to its calls,
+// as the middlewares of the routes are composed from their requirements.
+type Method struct {
+	// FullMethod is the full name of the method, such as /auth.v1.AuthService/SignUp.
+	FullMethod string
+	// Auth is the authentication the method requires.
+	Auth routehttputilpkg.AuthRequirement
+	// Transaction is the way the handler accesses the database.
+	Transaction routehttputilpkg.TransactionMode
+	// RateLimitPolicy is the name of the rate limit policy the calls are throttled with, if any.
+	RateLimitPolicy string
+}
+
+// Methods is a slice of Method.
+type Methods []Method
+
+// Name is the function that returns the name of the method without its service, such as SignUp.
+func (m Method) Name() string {
+	return m.FullMethod[strings.LastIndex(m.FullMethod, "/")+1:]
+}
+
+// Find is the function that finds a method by its full name.
+func (m Methods) Find(fullMethod string) (Method, bool) {
+	for _, method := range m {
+		if method.FullMethod == fullMethod {
+			return method, true
+		}
+	}
+
+	return Method{}, false
+}
+
+// Undeclared is the function that lists the full names of the methods of the services that are not declared,
+// so that a method added to a service cannot be served without its requirements.
+func (m Methods) Undeclared(serviceDescs ...*grpc.ServiceDesc) []string {
+	undeclared := make([]string, 0)
+
+	for _, serviceDesc := range serviceDescs {
+		names := make([]string, 0, len(serviceDesc.Methods)+len(serviceDesc.Streams))
+
+		for _, methodDesc := range serviceDesc.Methods {
+			names = append(names, methodDesc.MethodName)
+		}
+
+		for _, streamDesc := range serviceDesc.Streams {
+			names = append(names, streamDesc.StreamName)
+		}
+
+		for _, name := range names {
+			fullMethod := "/" + serviceDesc.ServiceName + "/" + name
+			if _, ok := m.Find(fullMethod); !ok {
+				undeclared = append(undeclared, fullMethod)
+			}
+		}
+	}
+
+	return undeclared
+}
@@ -0,0 +1,55 @@
+package method_test
+
+import (
+	"testing"
+
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestFind() {
+	methods := methodgrpcutilpkg.Methods{
+		{FullMethod: "/auth.v1.AuthService/SignUp", Transaction: routehttputilpkg.TransactionReadWrite},
+		{FullMethod: "/auth.v1.AuthService/SignOut", Auth: routehttputilpkg.AuthToken},
+	}
+
+	fullMethod := ""
+
+	method := methodgrpcutilpkg.Method{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInFindingTheMethod",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignOut"
+				method = methods[1]
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheMethodIsNotDeclared",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/grpc.health.v1.Health/Check"
+				method = methodgrpcutilpkg.Method{}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedMethod, ok := methods.Find(fullMethod)
+
+			if !tc.WantError {
+				assert.True(t, ok)
+				assert.Equal(t, method, returnedMethod)
+			} else {
+				assert.False(t, ok)
+				assert.Equal(t, method, returnedMethod)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package method_test
+
+import (
+	"testing"
+
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestName() {
+	method := methodgrpcutilpkg.Method{}
+
+	name := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheNameOfTheMethodWithoutItsService",
+			SetUp: func(t *testing.T) {
+				method = methodgrpcutilpkg.Method{FullMethod: "/auth.v1.AuthService/SignUp"}
+				name = "SignUp"
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedName := method.Name()
+
+			assert.Equal(t, name, returnedName)
+		})
+	}
+}
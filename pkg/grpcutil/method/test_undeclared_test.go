@@ -0,0 +1,66 @@
+package method_test
+
+import (
+	"testing"
+
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func (ts *TestSuite) TestUndeclared() {
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "auth.v1.AuthService",
+		Methods: []grpc.MethodDesc{
+			{MethodName: "SignUp"},
+			{MethodName: "SignOut"},
+		},
+		Streams: []grpc.StreamDesc{
+			{StreamName: "WatchSessions"},
+		},
+	}
+
+	methods := methodgrpcutilpkg.Methods{}
+
+	undeclared := []string{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInFindingNoUndeclaredMethodIfAllOfThemAreDeclared",
+			SetUp: func(t *testing.T) {
+				methods = methodgrpcutilpkg.Methods{
+					{FullMethod: "/auth.v1.AuthService/SignUp", Transaction: routehttputilpkg.TransactionReadWrite},
+					{FullMethod: "/auth.v1.AuthService/SignOut", Auth: routehttputilpkg.AuthToken},
+					{FullMethod: "/auth.v1.AuthService/WatchSessions", Auth: routehttputilpkg.AuthToken},
+				}
+				undeclared = []string{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfSomeMethodsAreNotDeclared",
+			SetUp: func(t *testing.T) {
+				methods = methodgrpcutilpkg.Methods{
+					{FullMethod: "/auth.v1.AuthService/SignUp", Transaction: routehttputilpkg.TransactionReadWrite},
+				}
+				undeclared = []string{"/auth.v1.AuthService/SignOut", "/auth.v1.AuthService/WatchSessions"}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedUndeclared := methods.Undeclared(serviceDesc)
+
+			if !tc.WantError {
+				assert.Empty(t, returnedUndeclared)
+			} else {
+				assert.Equal(t, undeclared, returnedUndeclared)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Domain is the domain of the errors described by the ErrorInfo details of the statuses.
+const Domain = "go-code-challenge-template"
+
+// Code is the function that gets the gRPC code of the type of an error.
+func Code(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	switch customerror.GetType(err) {
+	case customerror.BadRequest, customerror.UnprocessableEntity:
+		return codes.InvalidArgument
+	case customerror.Unauthorized:
+		return codes.Unauthenticated
+	case customerror.NotFound:
+		return codes.NotFound
+	case customerror.Conflict:
+		return codes.AlreadyExists
+	case customerror.ServiceUnavailable:
+		return codes.Unavailable
+	case customerror.PreconditionFailed, customerror.Locked:
+		return codes.FailedPrecondition
+	case customerror.TooManyRequests, customerror.RequestEntityTooLarge:
+		return codes.ResourceExhausted
+	case customerror.Forbidden:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+// Error is the function that converts an error into a gRPC status error, as the error responses of the API are built.
+// Only the public message of the error is returned, while the internal one and its stack are logged for the server errors.
+// The code of the error and its details are carried by an ErrorInfo detail, the errors of the fields by a BadRequest one
+// and the request ID stored in context, if any, by a RequestInfo one. An error that already is a status is kept as it is.
+func Error(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := Code(err)
+
+	if code == codes.Internal {
+		loggerpkg.FromContext(ctx).Error("request failed", slog.Any("error", err), slog.String("stack", customerror.GetStack(err)))
+	}
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: responsehttputilpkg.ErrorCode(err),
+		Domain: Domain,
+	}
+
+	for key, value := range customerror.GetDetails(err) {
+		if errorInfo.Metadata == nil {
+			errorInfo.Metadata = make(map[string]string)
+		}
+
+		errorInfo.Metadata[key] = fmt.Sprint(value)
+	}
+
+	details := []protoiface.MessageV1{errorInfo}
+
+	if fieldErrors := customerror.GetFieldErrors(err); len(fieldErrors) > 0 {
+		badRequest := &errdetails.BadRequest{}
+
+		for _, fieldError := range fieldErrors {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fieldError.Field,
+				Description: fieldError.Message,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	if requestID, ok := requestidmiddlewarepkg.FromContext(ctx); ok {
+		details = append(details, &errdetails.RequestInfo{RequestId: requestID})
+	}
+
+	st := status.New(code, customerror.GetPublicMessage(err))
+
+	// The details are only left out if they cannot be marshaled, which does not happen to the well-known ones.
+	if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
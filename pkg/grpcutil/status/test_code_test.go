@@ -0,0 +1,72 @@
+package status_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func (ts *TestSuite) TestCode() {
+	var err error
+
+	code := codes.OK
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfABadRequestError",
+			SetUp: func(t *testing.T) {
+				err = customerror.BadRequest.New("failed")
+				code = codes.InvalidArgument
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfAnUnauthorizedError",
+			SetUp: func(t *testing.T) {
+				err = customerror.Unauthorized.New("failed")
+				code = codes.Unauthenticated
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfAConflictError",
+			SetUp: func(t *testing.T) {
+				err = customerror.Wrap(customerror.Conflict.New("failed"), "failed")
+				code = codes.AlreadyExists
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfATooManyRequestsError",
+			SetUp: func(t *testing.T) {
+				err = customerror.TooManyRequests.New("failed")
+				code = codes.ResourceExhausted
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfACancelledContext",
+			SetUp: func(t *testing.T) {
+				err = customerror.Newf("failed: %w", context.Canceled)
+				code = codes.Canceled
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfANonTypeError",
+			SetUp: func(t *testing.T) {
+				err = customerror.New("failed")
+				code = codes.Internal
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedCode := statusgrpcutilpkg.Code(err)
+
+			assert.Equal(t, code, returnedCode)
+		})
+	}
+}
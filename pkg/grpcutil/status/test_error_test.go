@@ -0,0 +1,106 @@
+package status_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (ts *TestSuite) TestError() {
+	ctx := context.Background()
+
+	var err error
+
+	code := codes.OK
+
+	message := ""
+
+	details := []proto.Message{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInConvertingAnErrorWithItsCodeAndDetails",
+			SetUp: func(t *testing.T) {
+				ctx = requestidmiddlewarepkg.NewContext(context.Background(), "request-id")
+				err = customerror.WithCode(customerror.Conflict.New("the username is already registered"), "USERNAME_TAKEN")
+				err = customerror.WithDetails(err, "username", "user1")
+				code = codes.AlreadyExists
+				message = "the username is already registered"
+				details = []proto.Message{
+					&errdetails.ErrorInfo{
+						Reason:   "USERNAME_TAKEN",
+						Domain:   statusgrpcutilpkg.Domain,
+						Metadata: map[string]string{"username": "user1"},
+					},
+					&errdetails.RequestInfo{RequestId: "request-id"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInConvertingAnErrorWithTheErrorsOfItsFields",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				err = customerror.WithFieldErrors(customerror.BadRequest.New("failed"),
+					customerror.FieldError{Field: "username", Rule: "nonzero", Message: "the username is required"})
+				code = codes.InvalidArgument
+				message = "failed"
+				details = []proto.Message{
+					&errdetails.ErrorInfo{Reason: "BAD_REQUEST", Domain: statusgrpcutilpkg.Domain},
+					&errdetails.BadRequest{
+						FieldViolations: []*errdetails.BadRequest_FieldViolation{
+							{Field: "username", Description: "the username is required"},
+						},
+					},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInConvertingAnInternalErrorWithoutRevealingItsMessage",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				err = customerror.New("pq: relation \"users\" does not exist")
+				code = codes.Internal
+				message = "an unexpected error occurred"
+				details = []proto.Message{
+					&errdetails.ErrorInfo{Reason: "INTERNAL_ERROR", Domain: statusgrpcutilpkg.Domain},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInKeepingAnErrorThatAlreadyIsAStatus",
+			SetUp: func(t *testing.T) {
+				ctx = context.Background()
+				err = status.Error(codes.NotFound, "the service is unknown")
+				code = codes.NotFound
+				message = "the service is unknown"
+				details = []proto.Message{}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedErr := statusgrpcutilpkg.Error(ctx, err)
+
+			st, ok := status.FromError(returnedErr)
+			assert.True(t, ok)
+			assert.Equal(t, code, st.Code())
+			assert.Equal(t, message, st.Message())
+			assert.Equal(t, len(details), len(st.Details()))
+			for i, detail := range st.Details() {
+				assert.True(t, proto.Equal(details[i], detail.(proto.Message)), fmt.Sprintf("Unexpected detail: %v", detail))
+			}
+		})
+	}
+}
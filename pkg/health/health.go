@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// StatusPass is the status of a healthy component.
+	StatusPass = "pass"
+	// StatusFail is the status of an unhealthy component.
+	StatusFail = "fail"
+)
+
+// defaultTimeout is the time a check is given when the registry has no timeout.
+const defaultTimeout = 2 * time.Second
+
+// Checker is the interface implemented by the dependencies whose health is checked.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkerFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// NewChecker is the function that builds a checker from a function.
+func NewChecker(name string, check func(ctx context.Context) error) Checker {
+	return checkerFunc{name: name, check: check}
+}
+
+// Name is the function that returns the name of the checked component.
+func (c checkerFunc) Name() string {
+	return c.name
+}
+
+// Check is the function that checks the health of the component.
+func (c checkerFunc) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// ComponentStatus is the outcome of the check of a component, along with the last failure it had.
+type ComponentStatus struct {
+	Name        string
+	Status      string
+	Latency     time.Duration
+	Error       string
+	CheckedAt   time.Time
+	LastError   string
+	LastErrorAt time.Time
+}
+
+// Report is the outcome of the checks of all registered components.
+type Report struct {
+	Status     string
+	Components []ComponentStatus
+}
+
+// IsHealthy is the function that checks if all components passed.
+func (r Report) IsHealthy() bool {
+	return r.Status == StatusPass
+}
+
+type lastError struct {
+	message string
+	at      time.Time
+}
+
+// Registry is the component that keeps the checkers of the dependencies, so that new ones can register themselves.
+type Registry struct {
+	timeout time.Duration
+
+	mu         sync.Mutex
+	checkers   []Checker
+	lastErrors map[string]lastError
+}
+
+// NewRegistry is the factory function that encapsulates the implementation related to the registry of checkers.
+// Each check is given up to the timeout to finish.
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Registry{
+		timeout:    timeout,
+		checkers:   make([]Checker, 0),
+		lastErrors: make(map[string]lastError),
+	}
+}
+
+// Register is the function that adds checkers to the registry.
+func (r *Registry) Register(checkers ...Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkers = append(r.checkers, checkers...)
+}
+
+// Check is the function that runs the checks of all registered components concurrently.
+// The report passes only when every component passes.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.Lock()
+	checkers := make([]Checker, len(r.checkers))
+	copy(checkers, r.checkers)
+	r.mu.Unlock()
+
+	components := make([]ComponentStatus, len(checkers))
+
+	var wg sync.WaitGroup
+
+	for i, checker := range checkers {
+		wg.Add(1)
+
+		go func(i int, checker Checker) {
+			defer wg.Done()
+			components[i] = r.check(ctx, checker)
+		}(i, checker)
+	}
+
+	wg.Wait()
+
+	report := Report{Status: StatusPass, Components: components}
+
+	for _, component := range components {
+		if component.Status != StatusPass {
+			report.Status = StatusFail
+			break
+		}
+	}
+
+	return report
+}
+
+// check is the function that runs the check of a component and keeps track of its last failure.
+func (r *Registry) check(ctx context.Context, checker Checker) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	err := checker.Check(ctx)
+
+	component := ComponentStatus{
+		Name:      checker.Name(),
+		Status:    StatusPass,
+		Latency:   time.Since(start),
+		CheckedAt: start,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		component.Status = StatusFail
+		component.Error = err.Error()
+		r.lastErrors[component.Name] = lastError{message: err.Error(), at: start}
+	}
+
+	if last, ok := r.lastErrors[component.Name]; ok {
+		component.LastError = last.message
+		component.LastErrorAt = last.at
+	}
+
+	return component
+}
@@ -0,0 +1,83 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	healthpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestCheck() {
+	registry := healthpkg.NewRegistry(50 * time.Millisecond)
+
+	failing := false
+
+	registry.Register(
+		healthpkg.NewChecker("database", func(ctx context.Context) error {
+			return nil
+		}),
+		healthpkg.NewChecker("cache", func(ctx context.Context) error {
+			if failing {
+				return errors.New("failed")
+			}
+			return nil
+		}),
+		healthpkg.NewChecker("mailer", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}),
+	)
+
+	registry.Check(context.Background())
+
+	report := healthpkg.Report{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldFailIfAComponentDoesNotAnswerBeforeTheTimeout",
+			SetUp:   func(t *testing.T) {},
+			TearDown: func(t *testing.T) {
+				mailer := report.Components[2]
+				assert.Equal(t, "mailer", mailer.Name)
+				assert.Equal(t, healthpkg.StatusFail, mailer.Status)
+				assert.NotEmpty(t, mailer.Error)
+				assert.Less(t, mailer.Latency, time.Second)
+			},
+		},
+		{
+			Context: "ItShouldSucceedInKeepingTheLastErrorOfAComponentThatRecovered",
+			SetUp: func(t *testing.T) {
+				failing = true
+				registry.Check(context.Background())
+				failing = false
+			},
+			TearDown: func(t *testing.T) {
+				cache := report.Components[1]
+				assert.Equal(t, "cache", cache.Name)
+				assert.Equal(t, healthpkg.StatusPass, cache.Status)
+				assert.Empty(t, cache.Error)
+				assert.Equal(t, "failed", cache.LastError)
+				assert.False(t, cache.LastErrorAt.IsZero())
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			report = registry.Check(context.Background())
+
+			assert.False(t, report.IsHealthy())
+			assert.Equal(t, healthpkg.StatusFail, report.Status)
+			assert.Len(t, report.Components, 3)
+			assert.Equal(t, healthpkg.StatusPass, report.Components[0].Status)
+			assert.Empty(t, report.Components[0].LastError)
+
+			tc.TearDown(t)
+		})
+	}
+}
@@ -52,7 +52,7 @@ func addOneToCountHandlerFunc() func(http.HandlerFunc) http.HandlerFunc {
 			} else {
 				count, ok = i.(int)
 				if !ok {
-					responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+					responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					return
 				}
 				count += 1
@@ -57,7 +57,7 @@ func (ts *TestSuite) TestWith() {
 
 				count, ok := i.(int)
 				if !ok {
-					responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+					responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					return
 				}
 
@@ -0,0 +1,61 @@
+package etag
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+// Format is the function that builds the entity tag of a resource based on its version.
+func Format(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// SetHeader is the function that sets the ETag header of a response based on the version of the resource.
+func SetHeader(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", Format(version))
+}
+
+// ParseIfMatch is the function that gets the versions of the resource expected by the If-Match header of a request,
+// which may list several entity tags separated by commas. It returns no version when the header is not informed
+// or matches any entity tag, in which case the write is not conditional.
+func ParseIfMatch(r *http.Request) ([]int, error) {
+	value := strings.TrimSpace(strings.Join(r.Header.Values("If-Match"), ","))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	entityTags := strings.Split(value, ",")
+	versions := make([]int, 0, len(entityTags))
+
+	for _, entityTag := range entityTags {
+		entityTag = strings.TrimSpace(entityTag)
+
+		if strings.HasPrefix(entityTag, "W/") {
+			return nil, customerror.BadRequest.New("the If-Match header must inform strong entity tags")
+		}
+
+		version := Parse(entityTag)
+		if version == 0 {
+			return nil, customerror.BadRequest.Newf("the If-Match header %s is not a valid entity tag", entityTag)
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// Parse is the function that gets the version of a resource from its strong entity tag.
+// It returns zero when the entity tag is not the one of a version.
+func Parse(value string) int {
+	version, err := strconv.Atoi(strings.Trim(value, `"`))
+	if err != nil || version <= 0 || value != Format(version) {
+		return 0
+	}
+
+	return version
+}
@@ -0,0 +1,109 @@
+package etag_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	etaghttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/etag"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestParseIfMatch() {
+	ifMatch := ""
+
+	versions := []int(nil)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInParsingTheVersionOfTheIfMatchHeader",
+			SetUp: func(t *testing.T) {
+				ifMatch = etaghttputilpkg.Format(3)
+				versions = []int{3}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingTheVersionsOfTheListOfEntityTagsOfTheIfMatchHeader",
+			SetUp: func(t *testing.T) {
+				ifMatch = fmt.Sprintf("%s, %s,%s", etaghttputilpkg.Format(3), etaghttputilpkg.Format(4), etaghttputilpkg.Format(7))
+				versions = []int{3, 4, 7}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingNoVersionIfTheIfMatchHeaderIsNotInformed",
+			SetUp: func(t *testing.T) {
+				ifMatch = ""
+				versions = nil
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingNoVersionIfTheIfMatchHeaderMatchesAnyEntityTag",
+			SetUp: func(t *testing.T) {
+				ifMatch = "*"
+				versions = nil
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheIfMatchHeaderInformsAWeakEntityTag",
+			SetUp: func(t *testing.T) {
+				ifMatch = `W/"3"`
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheListOfEntityTagsOfTheIfMatchHeaderInformsAWeakEntityTag",
+			SetUp: func(t *testing.T) {
+				ifMatch = fmt.Sprintf(`%s, W/"4"`, etaghttputilpkg.Format(3))
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheListOfEntityTagsOfTheIfMatchHeaderHasAnEmptyEntityTag",
+			SetUp: func(t *testing.T) {
+				ifMatch = fmt.Sprintf("%s,,%s", etaghttputilpkg.Format(3), etaghttputilpkg.Format(4))
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheIfMatchHeaderIsNotQuoted",
+			SetUp: func(t *testing.T) {
+				ifMatch = "3"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheIfMatchHeaderIsNotAVersion",
+			SetUp: func(t *testing.T) {
+				ifMatch = `"abc"`
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/testing", nil)
+			if ifMatch != "" {
+				req.Header.Set("If-Match", ifMatch)
+			}
+
+			returnedVersions, err := etaghttputilpkg.ParseIfMatch(req)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, versions, returnedVersions)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost")
+				assert.Equal(t, customerror.BadRequest, customerror.GetType(err))
+			}
+		})
+	}
+}
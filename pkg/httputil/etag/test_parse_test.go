@@ -0,0 +1,51 @@
+package etag_test
+
+import (
+	"testing"
+
+	etaghttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/etag"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestParse() {
+	value := ""
+
+	version := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInParsingTheVersionOfTheEntityTag",
+			SetUp: func(t *testing.T) {
+				value = etaghttputilpkg.Format(3)
+				version = 3
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingNoVersionIfTheEntityTagIsNotTheOneOfAVersion",
+			SetUp: func(t *testing.T) {
+				value = `"abc"`
+				version = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingNoVersionIfTheEntityTagIsNotQuoted",
+			SetUp: func(t *testing.T) {
+				value = "3"
+				version = 0
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedVersion := etaghttputilpkg.Parse(value)
+
+			assert.Equal(t, version, returnedVersion)
+		})
+	}
+}
@@ -2,9 +2,13 @@ package request
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
 )
 
 // RequestData is the model of a request data.
@@ -57,3 +61,19 @@ func SetRequestHeaders(r *http.Request, headers map[string][]string) {
 		}
 	}
 }
+
+// DecodeJSON is the function that decodes the JSON body of a request into the given value.
+// A body larger than the limit set by http.MaxBytesReader results in a request entity too large error
+// and any other decoding failure in a bad request one.
+func DecodeJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return customerror.WithDetails(customerror.RequestEntityTooLarge.Newf("the request body must not be larger than %d bytes", maxBytesErr.Limit), "max_bytes", maxBytesErr.Limit)
+		}
+
+		return customerror.BadRequest.New(err.Error())
+	}
+
+	return nil
+}
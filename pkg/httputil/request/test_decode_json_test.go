@@ -0,0 +1,75 @@
+package request_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestDecodeJSON() {
+	type payload struct {
+		Username string `json:"username"`
+	}
+
+	body := ""
+
+	maxBytes := int64(0)
+
+	errorType := customerror.NoType
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInDecodingTheJSONBody",
+			SetUp: func(t *testing.T) {
+				body = `{"username":"username"}`
+				maxBytes = 1024
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheBodyIsNotAValidJSON",
+			SetUp: func(t *testing.T) {
+				body = `{"username":`
+				maxBytes = 1024
+				errorType = customerror.BadRequest
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheBodyIsLargerThanTheLimit",
+			SetUp: func(t *testing.T) {
+				body = fmt.Sprintf(`{"username":"%s"}`, strings.Repeat("a", 64))
+				maxBytes = 16
+				errorType = customerror.RequestEntityTooLarge
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/sign_in", strings.NewReader(body))
+			req.Body = http.MaxBytesReader(httptest.NewRecorder(), req.Body, maxBytes)
+
+			returnedPayload := payload{}
+
+			err := requesthttputilpkg.DecodeJSON(req, &returnedPayload)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, "username", returnedPayload.Username)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, errorType, customerror.GetType(err))
+			}
+		})
+	}
+}
@@ -2,5 +2,6 @@ package response
 
 // Error is the error's model used for handling the JSON message for an unsuccessful operation.
 type Error struct {
-	Text string `json:"error"`
+	Text      string `json:"error"`
+	RequestID string `json:"request_id,omitempty"`
 }
@@ -0,0 +1,95 @@
+package response
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+)
+
+var (
+	catalogMu sync.RWMutex
+	catalog   *i18npkg.Catalog
+)
+
+// SetCatalog is the function that sets the catalog of the messages used to localize the error responses.
+// The error responses keep their canonical English messages when there is no catalog.
+func SetCatalog(c *i18npkg.Catalog) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+
+	catalog = c
+}
+
+// getCatalog is the function that gets the catalog of the messages.
+func getCatalog() *i18npkg.Catalog {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	return catalog
+}
+
+// localize is the function that translates the messages of a problem into the locale stored in the context of the request
+// and returns the locale of its detail. The detail is found by the code of the problem and the messages of the errors
+// of the fields by their rules, and the canonical English ones are kept when the catalog has no translation for them.
+func localize(r *http.Request, problem *Problem, err error) string {
+	c := getCatalog()
+	if c == nil || r == nil {
+		return i18npkg.DefaultLocale
+	}
+
+	locale := i18npkg.FromContext(r.Context())
+	if locale == i18npkg.DefaultLocale {
+		return locale
+	}
+
+	fieldErrorsLocalized := len(problem.Errors) > 0
+
+	for i, fieldError := range problem.Errors {
+		params := make(map[string]interface{}, len(fieldError.Params))
+		for name, value := range fieldError.Params {
+			params[name] = value
+		}
+
+		message, ok := c.Message(locale, i18npkg.ValidationKeyPrefix+fieldError.Rule, params)
+		if !ok {
+			fieldErrorsLocalized = false
+			continue
+		}
+
+		problem.Errors[i].Message = message
+	}
+
+	// The detail of a failed validation lists the errors of the fields, the same way as the canonical message does.
+	if fieldErrorsLocalized {
+		messages := make([]string, 0, len(problem.Errors))
+
+		for _, fieldError := range problem.Errors {
+			if fieldError.Field == "" {
+				messages = append(messages, fieldError.Message)
+				continue
+			}
+
+			messages = append(messages, fieldError.Field+": "+fieldError.Message)
+		}
+
+		problem.Detail = strings.Join(messages, ", ")
+
+		return locale
+	}
+
+	// The errors without a type are hidden behind a generic message, whatever their codes are.
+	key := problem.Code
+	if customerror.GetType(err) == customerror.NoType {
+		key = internalErrorCode
+	}
+
+	if message, ok := c.Message(locale, key, problem.Details); ok {
+		problem.Detail = message
+		return locale
+	}
+
+	return i18npkg.DefaultLocale
+}
@@ -0,0 +1,150 @@
+package response
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+const (
+	// ContentTypeProblemJSON is the media type of the error responses following RFC 7807.
+	ContentTypeProblemJSON = "application/problem+json"
+	// ContentTypeJSON is the media type of the legacy error responses.
+	ContentTypeJSON = "application/json"
+)
+
+// Problem is the error's model following RFC 7807, used for handling the JSON message for an unsuccessful operation.
+type Problem struct {
+	Type      string                 `json:"type"`
+	Title     string                 `json:"title"`
+	Status    int                    `json:"status"`
+	Detail    string                 `json:"detail,omitempty"`
+	Instance  string                 `json:"instance,omitempty"`
+	Code      string                 `json:"code"`
+	RequestID string                 `json:"request_id,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Errors    []ProblemFieldError    `json:"errors,omitempty"`
+}
+
+// ProblemFieldError is the model of the error related to a single field of the request.
+type ProblemFieldError struct {
+	Field   string            `json:"field"`
+	Rule    string            `json:"rule,omitempty"`
+	Params  map[string]string `json:"params,omitempty"`
+	Message string            `json:"message"`
+}
+
+// internalErrorCode is the code of the errors that carry neither a code nor a type.
+const internalErrorCode = "INTERNAL_ERROR"
+
+// defaultCodes are the codes of the errors that carry no code of their own.
+var defaultCodes = map[customerror.ErrorType]string{
+	customerror.BadRequest:            "BAD_REQUEST",
+	customerror.Unauthorized:          "UNAUTHORIZED",
+	customerror.NotFound:              "NOT_FOUND",
+	customerror.Conflict:              "CONFLICT",
+	customerror.UnprocessableEntity:   "UNPROCESSABLE_ENTITY",
+	customerror.ServiceUnavailable:    "SERVICE_UNAVAILABLE",
+	customerror.PreconditionFailed:    "PRECONDITION_FAILED",
+	customerror.TooManyRequests:       "TOO_MANY_REQUESTS",
+	customerror.RequestEntityTooLarge: "REQUEST_ENTITY_TOO_LARGE",
+	customerror.Forbidden:             "FORBIDDEN",
+	customerror.Locked:                "LOCKED",
+}
+
+var (
+	problemTypeBaseURIMu sync.RWMutex
+	problemTypeBaseURI   string
+)
+
+// SetProblemTypeBaseURI is the function that sets the base URI of the documentation of the error codes.
+// The type of a problem is the base URI followed by its code in lower case, such as https://example.com/problems/username-taken,
+// and it is about:blank when the base URI is empty.
+func SetProblemTypeBaseURI(uri string) {
+	problemTypeBaseURIMu.Lock()
+	defer problemTypeBaseURIMu.Unlock()
+
+	problemTypeBaseURI = strings.TrimSuffix(uri, "/")
+}
+
+// problemType is the function that builds the type of a problem from its code.
+func problemType(code string) string {
+	problemTypeBaseURIMu.RLock()
+	defer problemTypeBaseURIMu.RUnlock()
+
+	if problemTypeBaseURI == "" {
+		return "about:blank"
+	}
+
+	return problemTypeBaseURI + "/" + strings.ReplaceAll(strings.ToLower(code), "_", "-")
+}
+
+// ErrorCode is the function that gets the code of an error, which is the default one of its type when it carries no code.
+func ErrorCode(err error) string {
+	code := customerror.GetCode(err)
+	if code == "" {
+		code = defaultCodes[customerror.GetType(err)]
+	}
+
+	if code == "" {
+		code = internalErrorCode
+	}
+
+	return code
+}
+
+// NewProblem is the function that builds the problem of an error responded with the status code.
+func NewProblem(r *http.Request, statusCode int, err error) Problem {
+	code := ErrorCode(err)
+
+	problem := Problem{
+		Type:    problemType(code),
+		Title:   http.StatusText(statusCode),
+		Status:  statusCode,
+		Detail:  customerror.GetPublicMessage(err),
+		Code:    code,
+		Details: customerror.GetDetails(err),
+	}
+
+	if r != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	for _, fieldError := range customerror.GetFieldErrors(err) {
+		problem.Errors = append(problem.Errors, ProblemFieldError{
+			Field:   fieldError.Field,
+			Rule:    fieldError.Rule,
+			Params:  fieldError.Params,
+			Message: fieldError.Message,
+		})
+	}
+
+	return problem
+}
+
+// acceptsProblemJSON is the function that checks if the client asked for the error responses following RFC 7807,
+// that is, it accepts application/problem+json. The other clients, including the ones that send no Accept header
+// or accept anything, get the legacy error responses they were built for.
+func acceptsProblemJSON(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, accept := range r.Header.Values("Accept") {
+		for _, value := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(value))
+			if err != nil {
+				continue
+			}
+
+			if mediaType == ContentTypeProblemJSON {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -3,14 +3,21 @@ package response
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
 )
 
 // RespondWithJSON is the function that generates a JSON response along with the suitable header and given status code.
 func RespondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
-	w.Header().Set("Content-Type", "application/json")
+	respondWithContentType(w, ContentTypeJSON, statusCode, payload)
+}
+
+// respondWithContentType is the function that generates a JSON response of the given media type.
+func respondWithContentType(w http.ResponseWriter, contentType string, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(statusCode)
 
 	response, err := json.Marshal(payload)
@@ -23,8 +30,13 @@ func RespondWithJSON(w http.ResponseWriter, statusCode int, payload interface{})
 	w.Write(response)
 }
 
-// RespondErrorWithJSON is the function that generates a JSON error response.
-func RespondErrorWithJSON(w http.ResponseWriter, err error) {
+// RespondErrorWithJSON is the function that generates a JSON error response following RFC 7807.
+// Only the public message of the error is returned, while the internal one and its stack are logged for the server errors.
+// The message is translated into the locale stored in the context of the request when the catalog has it,
+// while the logs keep the canonical English one.
+// Only the clients that accept application/problem+json get the problem, while the others get the legacy {"error": "..."} body.
+// The request ID set in the response header, if any, is included in the body so that the error can be traced.
+func RespondErrorWithJSON(w http.ResponseWriter, r *http.Request, err error) {
 	statusCode := 0
 
 	errorType := customerror.GetType(err)
@@ -40,9 +52,47 @@ func RespondErrorWithJSON(w http.ResponseWriter, err error) {
 		statusCode = http.StatusConflict
 	case customerror.UnprocessableEntity:
 		statusCode = http.StatusUnprocessableEntity
+	case customerror.ServiceUnavailable:
+		statusCode = http.StatusServiceUnavailable
+	case customerror.PreconditionFailed:
+		statusCode = http.StatusPreconditionFailed
+	case customerror.TooManyRequests:
+		statusCode = http.StatusTooManyRequests
+	case customerror.RequestEntityTooLarge:
+		statusCode = http.StatusRequestEntityTooLarge
+	case customerror.Forbidden:
+		statusCode = http.StatusForbidden
+	case customerror.Locked:
+		statusCode = http.StatusLocked
 	default:
 		statusCode = http.StatusInternalServerError
 	}
 
-	RespondWithJSON(w, statusCode, Error{Text: err.Error()})
+	if statusCode >= http.StatusInternalServerError {
+		logError(r, err)
+	}
+
+	requestID := w.Header().Get("X-Request-ID")
+
+	problem := NewProblem(r, statusCode, err)
+	problem.RequestID = requestID
+
+	w.Header().Set("Content-Language", localize(r, &problem, err))
+
+	if acceptsProblemJSON(r) {
+		respondWithContentType(w, ContentTypeProblemJSON, statusCode, problem)
+		return
+	}
+
+	RespondWithJSON(w, statusCode, Error{Text: problem.Detail, RequestID: requestID})
+}
+
+// logError is the function that logs the internal message and the stack of an error hidden from the client.
+func logError(r *http.Request, err error) {
+	logger := slog.Default()
+	if r != nil {
+		logger = loggerpkg.FromContext(r.Context())
+	}
+
+	logger.Error("request failed", slog.Any("error", err), slog.String("stack", customerror.GetStack(err)))
 }
@@ -0,0 +1,49 @@
+package response_test
+
+import (
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestErrorCode() {
+	var err error
+
+	code := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfTheError",
+			SetUp: func(t *testing.T) {
+				err = customerror.WithCode(customerror.Conflict.New("the username is already registered"), "USERNAME_TAKEN")
+				code = "USERNAME_TAKEN"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheDefaultCodeOfTheTypeOfTheError",
+			SetUp: func(t *testing.T) {
+				err = customerror.NotFound.New("the user was not found")
+				code = "NOT_FOUND"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheCodeOfTheInternalErrors",
+			SetUp: func(t *testing.T) {
+				err = customerror.New("failed")
+				code = "INTERNAL_ERROR"
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedCode := responsehttputilpkg.ErrorCode(err)
+
+			assert.Equal(t, code, returnedCode)
+		})
+	}
+}
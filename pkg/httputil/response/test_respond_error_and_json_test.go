@@ -17,22 +17,24 @@ func (ts *TestSuite) TestRespondErrorAndJson() {
 	statusCode := 0
 	var err error
 	payload := responsehttputilpkg.Error{}
+	accept := ""
 
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedInRespondingWithInternalServerErrorAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusInternalServerError
-				text := "failed"
-				err = customerror.New(text)
-				payload = responsehttputilpkg.Error{Text: text}
+				err = customerror.New("pq: relation users does not exist")
+				payload = responsehttputilpkg.Error{Text: "an unexpected error occurred"}
 			},
 		},
 		{
 			Context: "ItShouldSucceedInRespondingWithBadRequestAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusBadRequest
 				text := "failed"
 				err = customerror.BadRequest.New(text)
@@ -43,6 +45,7 @@ func (ts *TestSuite) TestRespondErrorAndJson() {
 			Context: "ItShouldSucceedInRespondingWithUnauthorizedAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusUnauthorized
 				text := "failed"
 				err = customerror.Unauthorized.New(text)
@@ -53,6 +56,7 @@ func (ts *TestSuite) TestRespondErrorAndJson() {
 			Context: "ItShouldSucceedInRespondingWithNotFoundAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusNotFound
 				text := "failed"
 				err = customerror.NotFound.New(text)
@@ -63,6 +67,7 @@ func (ts *TestSuite) TestRespondErrorAndJson() {
 			Context: "ItShouldSucceedInRespondingWithConflictAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusConflict
 				text := "failed"
 				err = customerror.Conflict.New(text)
@@ -73,19 +78,146 @@ func (ts *TestSuite) TestRespondErrorAndJson() {
 			Context: "ItShouldSucceedInRespondingWithUnprocessableEntityAndJsonBody",
 			SetUp: func(t *testing.T) {
 				res = httptest.NewRecorder()
+				accept = "application/json"
 				statusCode = http.StatusUnprocessableEntity
 				text := "failed"
 				err = customerror.UnprocessableEntity.New(text)
 				payload = responsehttputilpkg.Error{Text: text}
 			},
 		},
+		{
+			Context: "ItShouldSucceedInRespondingWithServiceUnavailableAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusServiceUnavailable
+				text := "failed"
+				err = customerror.ServiceUnavailable.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithPreconditionFailedAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusPreconditionFailed
+				text := "failed"
+				err = customerror.PreconditionFailed.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTooManyRequestsAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusTooManyRequests
+				text := "failed"
+				err = customerror.TooManyRequests.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithRequestEntityTooLargeAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusRequestEntityTooLarge
+				text := "failed"
+				err = customerror.RequestEntityTooLarge.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithForbiddenAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusForbidden
+				text := "failed"
+				err = customerror.Forbidden.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithLockedAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusLocked
+				text := "failed"
+				err = customerror.Locked.New(text)
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTheWrappedErrorTypeAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusNotFound
+				err = fmt.Errorf("wrapped: %w", customerror.NotFound.New("failed"))
+				payload = responsehttputilpkg.Error{Text: "wrapped: failed"}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithThePublicMessageAndJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				statusCode = http.StatusInternalServerError
+				err = customerror.WithPublicMessage(customerror.New("pq: relation users does not exist"), "the user could not be registered")
+				payload = responsehttputilpkg.Error{Text: "the user could not be registered"}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTheRequestIDInTheJsonBody",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "application/json"
+				requestID := "request-id"
+				res.Header().Set("X-Request-ID", requestID)
+				statusCode = http.StatusBadRequest
+				text := "failed"
+				err = customerror.BadRequest.New(text)
+				payload = responsehttputilpkg.Error{Text: text, RequestID: requestID}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAJsonBodyWhenTheAcceptHeaderIsNotInformed",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = ""
+				statusCode = http.StatusConflict
+				text := "the username is already registered"
+				err = customerror.WithCode(customerror.Conflict.New(text), "USERNAME_TAKEN")
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAJsonBodyWhenTheAcceptHeaderAcceptsAnyMediaType",
+			SetUp: func(t *testing.T) {
+				res = httptest.NewRecorder()
+				accept = "*/*"
+				statusCode = http.StatusConflict
+				text := "the username is already registered"
+				err = customerror.WithCode(customerror.Conflict.New(text), "USERNAME_TAKEN")
+				payload = responsehttputilpkg.Error{Text: text}
+			},
+		},
 	}
 
 	for _, tc := range ts.Cases {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			responsehttputilpkg.RespondErrorWithJSON(res, err)
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if accept != "" {
+				req.Header.Set("Accept", accept)
+			}
+
+			responsehttputilpkg.RespondErrorWithJSON(res, req, err)
 
 			assert.Equal(t, res.Result().Header.Get("Content-Type"), "application/json")
 			assert.Equal(t, statusCode, res.Result().StatusCode)
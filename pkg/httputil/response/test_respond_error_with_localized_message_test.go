@@ -0,0 +1,127 @@
+package response_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestRespondErrorWithLocalizedMessage() {
+	req := &http.Request{}
+	var err error
+	contentLanguage := ""
+	problem := responsehttputilpkg.Problem{}
+
+	catalog, catalogErr := i18npkg.New()
+	assert.Nil(ts.T(), catalogErr, fmt.Sprintf("Unexpected error: %v", catalogErr))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRespondingWithTheMessageOfTheCodeTranslatedIntoTheLocale",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodPost, "/sign_up", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				req = req.WithContext(i18npkg.NewContext(req.Context(), i18npkg.BrazilianPortuguese))
+				err = customerror.WithCode(customerror.Conflict.New("the username user1 is already registered"), "USERNAME_TAKEN")
+				err = customerror.WithDetails(err, "username", "user1")
+				contentLanguage = i18npkg.BrazilianPortuguese
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Conflict",
+					Status:   http.StatusConflict,
+					Detail:   "o nome de usuário user1 já está cadastrado",
+					Instance: "/sign_up",
+					Code:     "USERNAME_TAKEN",
+					Details:  map[string]interface{}{"username": "user1"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTheMessageOfAFailedPreconditionTranslatedIntoTheLocale",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodPost, "/v1/change_password", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				req = req.WithContext(i18npkg.NewContext(req.Context(), i18npkg.BrazilianPortuguese))
+				err = customerror.WithCode(customerror.PreconditionFailed.New("the login was modified since version 2, 3"), "LOGIN_VERSION_MISMATCH")
+				err = customerror.WithDetails(err, "version", "2, 3")
+				contentLanguage = i18npkg.BrazilianPortuguese
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Precondition Failed",
+					Status:   http.StatusPreconditionFailed,
+					Detail:   "o login foi modificado desde a versão 2, 3",
+					Instance: "/v1/change_password",
+					Code:     "LOGIN_VERSION_MISMATCH",
+					Details:  map[string]interface{}{"version": "2, 3"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTheMessagesOfTheFieldErrorsTranslatedIntoTheLocale",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				req = req.WithContext(i18npkg.NewContext(req.Context(), i18npkg.Spanish))
+				err = customerror.WithFieldErrors(customerror.BadRequest.New("password: must be at least 8"),
+					customerror.FieldError{Field: "password", Rule: "min", Params: map[string]string{"min": "8"}, Message: "must be at least 8"})
+				contentLanguage = i18npkg.Spanish
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Bad Request",
+					Status:   http.StatusBadRequest,
+					Detail:   "password: debe ser como mínimo 8",
+					Instance: "/sign_in",
+					Code:     "BAD_REQUEST",
+					Errors: []responsehttputilpkg.ProblemFieldError{
+						{Field: "password", Rule: "min", Params: map[string]string{"min": "8"}, Message: "debe ser como mínimo 8"},
+					},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithTheCanonicalMessageIfTheCatalogHasNoTranslationForIt",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodGet, "/user", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				req = req.WithContext(i18npkg.NewContext(req.Context(), i18npkg.Spanish))
+				err = customerror.NotFound.New("the user was not found")
+				contentLanguage = i18npkg.English
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Not Found",
+					Status:   http.StatusNotFound,
+					Detail:   "the user was not found",
+					Instance: "/user",
+					Code:     "NOT_FOUND",
+				}
+			},
+		},
+	}
+
+	responsehttputilpkg.SetCatalog(catalog)
+	defer responsehttputilpkg.SetCatalog(nil)
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			res := httptest.NewRecorder()
+
+			responsehttputilpkg.RespondErrorWithJSON(res, req, err)
+
+			assert.Equal(t, contentLanguage, res.Result().Header.Get("Content-Language"))
+			assert.Equal(t, problem.Status, res.Result().StatusCode)
+			returnedProblem := responsehttputilpkg.Problem{}
+			err := json.NewDecoder(res.Body).Decode(&returnedProblem)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, problem, returnedProblem)
+		})
+	}
+}
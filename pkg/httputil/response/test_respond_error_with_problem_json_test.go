@@ -0,0 +1,115 @@
+package response_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestRespondErrorWithProblemJson() {
+	req := &http.Request{}
+	var err error
+	problem := responsehttputilpkg.Problem{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRespondingWithAProblemWhenTheAcceptHeaderAsksForIt",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodPost, "/sign_up", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				err = customerror.WithCode(customerror.Conflict.New("the username is already registered"), "USERNAME_TAKEN")
+				err = customerror.WithDetails(err, "username", "user1")
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Conflict",
+					Status:   http.StatusConflict,
+					Detail:   "the username is already registered",
+					Instance: "/sign_up",
+					Code:     "USERNAME_TAKEN",
+					Details:  map[string]interface{}{"username": "user1"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAProblemWhenTheAcceptHeaderPrefersIt",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodGet, "/user", nil)
+				req.Header.Set("Accept", "application/json, application/problem+json;q=0.9")
+				err = customerror.New("failed")
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Internal Server Error",
+					Status:   http.StatusInternalServerError,
+					Detail:   "an unexpected error occurred",
+					Instance: "/user",
+					Code:     "INTERNAL_ERROR",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAProblemWithTheDefaultCodeAndTheFieldErrors",
+			SetUp: func(t *testing.T) {
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				err = customerror.WithFieldErrors(customerror.BadRequest.New("failed"),
+					customerror.FieldError{Field: "username", Message: "failed"})
+				problem = responsehttputilpkg.Problem{
+					Type:     "about:blank",
+					Title:    "Bad Request",
+					Status:   http.StatusBadRequest,
+					Detail:   "failed",
+					Instance: "/sign_in",
+					Code:     "BAD_REQUEST",
+					Errors:   []responsehttputilpkg.ProblemFieldError{{Field: "username", Message: "failed"}},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAProblemWhoseTypeIsBasedOnTheCode",
+			SetUp: func(t *testing.T) {
+				responsehttputilpkg.SetProblemTypeBaseURI("https://example.com/problems/")
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+				err = customerror.WithCode(customerror.Unauthorized.New("the token has expired"), "TOKEN_EXPIRED")
+				problem = responsehttputilpkg.Problem{
+					Type:     "https://example.com/problems/token-expired",
+					Title:    "Unauthorized",
+					Status:   http.StatusUnauthorized,
+					Detail:   "the token has expired",
+					Instance: "/sign_in",
+					Code:     "TOKEN_EXPIRED",
+				}
+			},
+			TearDown: func(t *testing.T) {
+				responsehttputilpkg.SetProblemTypeBaseURI("")
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			res := httptest.NewRecorder()
+
+			responsehttputilpkg.RespondErrorWithJSON(res, req, err)
+
+			assert.Equal(t, "application/problem+json", res.Result().Header.Get("Content-Type"))
+			assert.Equal(t, problem.Status, res.Result().StatusCode)
+			returnedProblem := responsehttputilpkg.Problem{}
+			err := json.NewDecoder(res.Body).Decode(&returnedProblem)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, problem, returnedProblem)
+
+			if tc.TearDown != nil {
+				tc.TearDown(t)
+			}
+		})
+	}
+}
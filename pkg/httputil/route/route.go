@@ -1,17 +1,247 @@
 package route
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
 )
 
+// V1 is the first version of the API, whose routes are mounted under /v1.
+const V1 = "v1"
+
+// AuthRequirement is the authentication a route requires.
+type AuthRequirement int
+
+const (
+	// AuthNone is the requirement of the public routes.
+	AuthNone AuthRequirement = iota
+	// AuthToken is the requirement of the routes that need a valid token of a logged in user.
+	AuthToken
+	// AuthTokenRenewal is the requirement of the routes that need a token that is about to expire.
+	AuthTokenRenewal
+)
+
+// String is the function that returns the name of the auth requirement.
+func (a AuthRequirement) String() string {
+	switch a {
+	case AuthNone:
+		return "none"
+	case AuthToken:
+		return "token"
+	case AuthTokenRenewal:
+		return "token_renewal"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(a))
+	}
+}
+
+// TransactionMode is the way the handler of a route accesses the database.
+type TransactionMode int
+
+const (
+	// TransactionNone is the mode of the routes whose handlers run their own queries.
+	TransactionNone TransactionMode = iota
+	// TransactionReadWrite is the mode of the routes whose handlers run in a database transaction,
+	// which is committed when they succeed and rolled back otherwise.
+	TransactionReadWrite
+)
+
+// String is the function that returns the name of the transaction mode.
+func (t TransactionMode) String() string {
+	switch t {
+	case TransactionNone:
+		return "none"
+	case TransactionReadWrite:
+		return "read_write"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// Instrumentation is the telemetry collected from the requests of a route.
+type Instrumentation int
+
+const (
+	// InstrumentationFull is the instrumentation of the routes whose requests are measured, logged and traced.
+	InstrumentationFull Instrumentation = iota
+	// InstrumentationMetrics is the instrumentation of the routes requested too often to be logged and traced,
+	// such as the liveness and readiness probes.
+	InstrumentationMetrics
+	// InstrumentationNone is the instrumentation of the routes that would pollute the telemetry, such as the metrics scrapes.
+	InstrumentationNone
+)
+
+// String is the function that returns the name of the instrumentation.
+func (i Instrumentation) String() string {
+	switch i {
+	case InstrumentationFull:
+		return "full"
+	case InstrumentationMetrics:
+		return "metrics"
+	case InstrumentationNone:
+		return "none"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(i))
+	}
+}
+
 // Route is the model of a route.
+// A route with a version is mounted under it, such as /v1/sign_up, while a route without one is mounted at the root.
+// The requirements of the route are declared by its fields, from which the chain of middlewares of its handler is composed.
 type Route struct {
 	Name        string
 	Method      string
+	Version     string
 	PathPrefix  string
 	Path        string
 	HandlerFunc http.HandlerFunc
+	Deprecation *Deprecation
+	// Auth is the authentication the route requires.
+	Auth AuthRequirement
+	// Permissions are the permissions the authenticated user must have, which require the route to be authenticated.
+	Permissions []string
+	// Transaction is the way the handler accesses the database.
+	Transaction TransactionMode
+	// RateLimitPolicy is the name of the rate limit policy that throttles the route, if any.
+	RateLimitPolicy string
+	// Timeout is the time the handler is given to respond, after which the context of the request is cancelled.
+	// The route has no timeout of its own if it is zero.
+	Timeout time.Duration
+	// Instrumentation is the telemetry collected from the requests.
+	Instrumentation Instrumentation
+	// Doc is the documentation of the route, from which its operation in the API specification is generated.
+	Doc *Doc
+}
+
+// Doc is the documentation of a route.
+type Doc struct {
+	Summary string
+	Tags    []string
+	// Request is a value of the type of the JSON body of the request, if the route has one.
+	Request interface{}
+	// Headers are the descriptions of the optional headers of the request by name.
+	Headers map[string]string
+	// Responses are values of the types of the JSON bodies of the successful responses by status code.
+	// A nil value documents a response without a JSON body. The error responses are documented for every route.
+	Responses map[int]interface{}
+}
+
+// Deprecation is the model of the deprecation of a route, signalled to clients by the Deprecation and Sunset headers.
+type Deprecation struct {
+	// Date is when the route was deprecated. The route is deprecated without a known date if it is zero.
+	Date time.Time
+	// Sunset is when the route is going to be removed, if it is planned.
+	Sunset time.Time
+	// Link is the URI of the documentation of the deprecation, if any.
+	Link string
+	// Successor is the path of the route that replaces the deprecated one, if any.
+	Successor string
 }
 
 // Routes is a slice of Route.
 type Routes []Route
+
+// FullPathPrefix is the function that returns the path prefix of the route preceded by its version, if any.
+func (r Route) FullPathPrefix() string {
+	return versioned(r.Version, r.PathPrefix)
+}
+
+// FullPath is the function that returns the path of the route preceded by its version, if any.
+func (r Route) FullPath() string {
+	return versioned(r.Version, r.Path)
+}
+
+// versioned is the function that prefixes a path with a version.
+func versioned(version string, path string) string {
+	if version == "" || path == "" {
+		return path
+	}
+
+	return "/" + version + path
+}
+
+// WithUnversionedAliases is the function that returns the routes along with an unversioned alias of each versioned one,
+// such as /sign_up for /v1/sign_up, so that the clients of the routes registered before the versioning keep working.
+// The aliases are deprecated in favour of the versioned routes they stand for.
+func (routes Routes) WithUnversionedAliases(deprecation Deprecation) Routes {
+	aliasedRoutes := make(Routes, 0, len(routes)*2)
+	aliasedRoutes = append(aliasedRoutes, routes...)
+
+	for _, route := range routes {
+		if route.Version == "" {
+			continue
+		}
+
+		aliasDeprecation := deprecation
+		if route.Deprecation != nil {
+			aliasDeprecation = *route.Deprecation
+		}
+
+		if route.Path != "" {
+			aliasDeprecation.Successor = route.FullPath()
+		} else {
+			aliasDeprecation.Successor = route.FullPathPrefix()
+		}
+
+		alias := route
+		alias.Version = ""
+		alias.Deprecation = &aliasDeprecation
+
+		aliasedRoutes = append(aliasedRoutes, alias)
+	}
+
+	return aliasedRoutes
+}
+
+// Listing is the function that returns a table of the routes along with their requirements, for debugging.
+func (routes Routes) Listing() string {
+	var builder strings.Builder
+
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "METHOD\tPATH\tNAME\tAUTH\tPERMISSIONS\tTRANSACTION\tRATE LIMIT\tTIMEOUT\tINSTRUMENTATION\tDEPRECATED")
+
+	for _, route := range routes {
+		path := route.FullPath()
+		if path == "" {
+			path = route.FullPathPrefix() + "/*"
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%t\n",
+			route.Method,
+			path,
+			route.Name,
+			route.Auth,
+			orDash(strings.Join(route.Permissions, ",")),
+			route.Transaction,
+			orDash(route.RateLimitPolicy),
+			orDash(durationOrEmpty(route.Timeout)),
+			route.Instrumentation,
+			route.Deprecation != nil,
+		)
+	}
+
+	writer.Flush()
+
+	return builder.String()
+}
+
+// orDash is the function that replaces an empty value of the listing by a dash.
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+// durationOrEmpty is the function that formats a duration of the listing, which is empty if it is zero.
+func durationOrEmpty(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	return d.String()
+}
@@ -0,0 +1,47 @@
+package route_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestListing() {
+	routes := routehttputilpkg.Routes{}
+
+	lines := []string{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInListingTheRoutesAlongWithTheirRequirements",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{Name: "ChangePassword", Method: http.MethodPost, Version: routehttputilpkg.V1, Path: "/change_password",
+						Auth: routehttputilpkg.AuthToken, RateLimitPolicy: "change_password", Timeout: 5 * time.Second},
+					{Name: "Swagger", Method: http.MethodGet, PathPrefix: "/swagger",
+						Deprecation: &routehttputilpkg.Deprecation{}},
+				}
+				lines = []string{
+					"METHOD  PATH                 NAME            AUTH   PERMISSIONS  TRANSACTION  RATE LIMIT       TIMEOUT  INSTRUMENTATION  DEPRECATED",
+					"POST    /v1/change_password  ChangePassword  token  -            none         change_password  5s       full             false",
+					"GET     /swagger/*           Swagger         none   -            none         -                -        full             true",
+				}
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			listing := routes.Listing()
+
+			assert.Equal(t, strings.Join(lines, "\n")+"\n", listing)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package route_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWithUnversionedAliases() {
+	routes := routehttputilpkg.Routes{}
+
+	deprecation := routehttputilpkg.Deprecation{}
+
+	aliasedRoutes := routehttputilpkg.Routes{}
+
+	sunset := time.Date(2027, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAddingADeprecatedAliasOfEachVersionedRoute",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{Name: "GetStatus", Method: http.MethodGet, Path: "/status"},
+					{Name: "SignUp", Method: http.MethodPost, Version: routehttputilpkg.V1, Path: "/sign_up"},
+				}
+				deprecation = routehttputilpkg.Deprecation{Sunset: sunset}
+				aliasedRoutes = routehttputilpkg.Routes{
+					{Name: "GetStatus", Method: http.MethodGet, Path: "/status"},
+					{Name: "SignUp", Method: http.MethodPost, Version: routehttputilpkg.V1, Path: "/sign_up"},
+					{Name: "SignUp", Method: http.MethodPost, Path: "/sign_up",
+						Deprecation: &routehttputilpkg.Deprecation{Sunset: sunset, Successor: "/v1/sign_up"}},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInKeepingTheDeprecationOfAVersionedRouteInItsAlias",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					{Name: "GetAllUsers", Method: http.MethodGet, Version: routehttputilpkg.V1, Path: "/users",
+						Deprecation: &routehttputilpkg.Deprecation{Link: "https://example.com/deprecations/users"}},
+				}
+				deprecation = routehttputilpkg.Deprecation{Sunset: sunset}
+				aliasedRoutes = routehttputilpkg.Routes{
+					{Name: "GetAllUsers", Method: http.MethodGet, Version: routehttputilpkg.V1, Path: "/users",
+						Deprecation: &routehttputilpkg.Deprecation{Link: "https://example.com/deprecations/users"}},
+					{Name: "GetAllUsers", Method: http.MethodGet, Path: "/users",
+						Deprecation: &routehttputilpkg.Deprecation{Link: "https://example.com/deprecations/users", Successor: "/v1/users"}},
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRoutes := routes.WithUnversionedAliases(deprecation)
+
+			assert.Equal(t, aliasedRoutes, returnedRoutes)
+		})
+	}
+}
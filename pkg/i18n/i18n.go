@@ -0,0 +1,146 @@
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// English locale, which is the one of the canonical messages.
+	English = "en"
+	// BrazilianPortuguese locale.
+	BrazilianPortuguese = "pt-BR"
+	// Spanish locale.
+	Spanish = "es"
+)
+
+// DefaultLocale is the locale used when none of the preferred ones is supported.
+const DefaultLocale = English
+
+// ValidationKeyPrefix is the prefix of the keys of the messages of the validation rules, such as validation.min.
+const ValidationKeyPrefix = "validation."
+
+// paramRegexp matches the parameters of the templates, such as {username}.
+var paramRegexp = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+//go:embed locales/*.json
+var localesFS embed.FS
+
+var localeCtxKey = &contextKey{"locale"}
+
+type contextKey struct {
+	name string
+}
+
+// Catalog is the component that keeps the message templates by locale, keyed by error code or validation rule.
+// The templates reference their parameters by name between braces, such as "the username {username} is already registered".
+type Catalog struct {
+	locales  []string
+	messages map[string]map[string]string
+	matcher  language.Matcher
+}
+
+// New is the factory function that encapsulates the implementation related to the catalog of the embedded messages.
+func New() (*Catalog, error) {
+	locales := []string{English, BrazilianPortuguese, Spanish}
+
+	messages := make(map[string]map[string]string, len(locales))
+	tags := make([]language.Tag, 0, len(locales))
+
+	for _, locale := range locales {
+		content, err := localesFS.ReadFile("locales/" + locale + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the messages of the locale %s: %s", locale, err.Error())
+		}
+
+		localeMessages := make(map[string]string)
+		if err = json.Unmarshal(content, &localeMessages); err != nil {
+			return nil, fmt.Errorf("failed to parse the messages of the locale %s: %s", locale, err.Error())
+		}
+
+		messages[locale] = localeMessages
+		tags = append(tags, language.MustParse(locale))
+	}
+
+	return &Catalog{
+		locales:  locales,
+		messages: messages,
+		matcher:  language.NewMatcher(tags),
+	}, nil
+}
+
+// Locales is the function that returns the supported locales, the default one first.
+func (c *Catalog) Locales() []string {
+	return append([]string{}, c.locales...)
+}
+
+// Negotiate is the function that picks the supported locale that best matches the preferences,
+// which are either locales such as pt-BR or Accept-Language header values. The first preference that matches wins.
+func (c *Catalog) Negotiate(preferences ...string) string {
+	for _, preference := range preferences {
+		if strings.TrimSpace(preference) == "" {
+			continue
+		}
+
+		tags, _, err := language.ParseAcceptLanguage(preference)
+		if err != nil || len(tags) == 0 {
+			continue
+		}
+
+		_, index, confidence := c.matcher.Match(tags...)
+		if confidence != language.No {
+			return c.locales[index]
+		}
+	}
+
+	return DefaultLocale
+}
+
+// Message is the function that renders the message of the key in the locale with the parameters.
+// It reports false when the locale has no message for the key or when a parameter of the template is missing,
+// so that the caller can fall back to the canonical message.
+func (c *Catalog) Message(locale, key string, params map[string]interface{}) (string, bool) {
+	template, ok := c.messages[locale][key]
+	if !ok {
+		return "", false
+	}
+
+	return Render(template, params)
+}
+
+// Render is the function that replaces the parameters of the template by their values.
+// It reports false when a parameter of the template is missing. Only the template is checked,
+// so the values may contain braces, such as the pattern ^[a-z]{5,}$ of the regexp rule.
+func Render(template string, params map[string]interface{}) (string, bool) {
+	for _, match := range paramRegexp.FindAllStringSubmatch(template, -1) {
+		if _, ok := params[match[1]]; !ok {
+			return "", false
+		}
+	}
+
+	message := paramRegexp.ReplaceAllStringFunc(template, func(param string) string {
+		return fmt.Sprint(params[param[1:len(param)-1]])
+	})
+
+	return message, true
+}
+
+// NewContext is the function that returns a new context with the locale.
+func NewContext(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeCtxKey, locale)
+}
+
+// FromContext is the function that returns the locale stored in context, or the default locale if there is none.
+func FromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeCtxKey).(string); ok && locale != "" {
+		return locale
+	}
+
+	return DefaultLocale
+}
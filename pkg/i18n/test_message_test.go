@@ -0,0 +1,98 @@
+package i18n_test
+
+import (
+	"fmt"
+	"testing"
+
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestMessage() {
+	locale := ""
+
+	key := ""
+
+	params := map[string]interface{}{}
+
+	message := ""
+
+	catalog, err := i18npkg.New()
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRenderingTheMessageOfAnErrorCode",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.BrazilianPortuguese
+				key = "USERNAME_TAKEN"
+				params = map[string]interface{}{"username": "johndoe"}
+				message = "o nome de usuário johndoe já está cadastrado"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRenderingTheMessageOfAValidationRule",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.Spanish
+				key = i18npkg.ValidationKeyPrefix + "min"
+				params = map[string]interface{}{"min": "8"}
+				message = "debe ser como mínimo 8"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRenderingTheMessageOfAValidationRuleWhoseParameterContainsBraces",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.BrazilianPortuguese
+				key = i18npkg.ValidationKeyPrefix + "regexp"
+				params = map[string]interface{}{"regexp": "^[a-z]{5,}$"}
+				message = "deve corresponder ao padrão ^[a-z]{5,}$"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRenderingTheMessageOfAnErrorCodeWhoseParameterLooksLikeAnotherOne",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.English
+				key = "USERNAME_TAKEN"
+				params = map[string]interface{}{"username": "{username}"}
+				message = "the username {username} is already registered"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheLocaleHasNoMessageForTheKey",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.BrazilianPortuguese
+				key = "UNKNOWN_CODE"
+				params = nil
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfAParameterOfTheTemplateIsMissing",
+			SetUp: func(t *testing.T) {
+				locale = i18npkg.Spanish
+				key = "USERNAME_TAKEN"
+				params = nil
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedMessage, ok := catalog.Message(locale, key, params)
+
+			if !tc.WantError {
+				assert.True(t, ok)
+				assert.Equal(t, message, returnedMessage)
+			} else {
+				assert.False(t, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package i18n_test
+
+import (
+	"fmt"
+	"testing"
+
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNegotiate() {
+	preferences := []string{}
+
+	locale := ""
+
+	catalog, err := i18npkg.New()
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInNegotiatingTheLocaleFromAnAcceptLanguageHeader",
+			SetUp: func(t *testing.T) {
+				preferences = []string{"fr-CH, fr;q=0.9, pt;q=0.8, en;q=0.7"}
+				locale = i18npkg.BrazilianPortuguese
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInNegotiatingTheLocaleFromARegionalVariant",
+			SetUp: func(t *testing.T) {
+				preferences = []string{"es-AR"}
+				locale = i18npkg.Spanish
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInNegotiatingTheLocaleFromTheFirstPreferenceThatMatches",
+			SetUp: func(t *testing.T) {
+				preferences = []string{"", "invalid;;", "es", "pt-BR"}
+				locale = i18npkg.Spanish
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInNegotiatingTheDefaultLocaleIfThereAreNoPreferences",
+			SetUp: func(t *testing.T) {
+				preferences = []string{}
+				locale = i18npkg.DefaultLocale
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedLocale := catalog.Negotiate(preferences...)
+
+			assert.Equal(t, locale, returnedLocale)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+// MetadataKey is the key of the metadata entry that carries the token as "Bearer <token>".
+const MetadataKey = "authorization"
+
+// authHeader is the function that gets the value of the authorization metadata of a call.
+func authHeader(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// Auth is the function that intercepts a unary call to evaluate its authentication based on a JWT token,
+// according to the requirement of its method. The calls of the methods that are not declared are rejected,
+// so that a method cannot be served without its requirement by mistake. The auth details are stored in context, as the auth middlewares of the API do.
+func Auth(db *gorm.DB, authN authpkg.IAuth, timeBeforeTokenExpTimeInSec int, methods methodgrpcutilpkg.Methods) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := methods.Find(info.FullMethod)
+		if !ok {
+			return nil, statusgrpcutilpkg.Error(ctx, customerror.Forbidden.Newf("the method %s is not declared", info.FullMethod))
+		}
+
+		if method.Auth == routehttputilpkg.AuthNone {
+			return handler(ctx, req)
+		}
+
+		var auth domainentity.Auth
+		var err error
+
+		switch method.Auth {
+		case routehttputilpkg.AuthTokenRenewal:
+			auth, err = authmiddlewarepkg.AuthenticateRenewal(ctx, db, authN, authHeader(ctx), timeBeforeTokenExpTimeInSec)
+		default:
+			auth, err = authmiddlewarepkg.Authenticate(ctx, db, authN, authHeader(ctx))
+		}
+
+		if err != nil {
+			return nil, statusgrpcutilpkg.Error(ctx, err)
+		}
+
+		// It is necessary to set auth details that can be used for performing authenticated operations.
+		ctx = authmiddlewarepkg.NewContext(ctx, auth)
+
+		loggerpkg.With(ctx, slog.String("user_id", auth.UserID.String()), slog.String("auth_id", auth.ID.String()))
+
+		return handler(ctx, req)
+	}
+}
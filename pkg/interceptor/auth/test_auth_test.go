@@ -0,0 +1,208 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	fake "github.com/brianvoe/gofakeit/v5"
+	"github.com/golang-jwt/jwt"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	authinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/auth"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	mockauthpkg "github.com/icaroribeiro/go-code-challenge-template/tests/mocks/pkg/mockauth"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (ts *TestSuite) TestAuth() {
+	driver := "postgres"
+	db, sqlMock := NewMockDB(driver)
+
+	timeBeforeTokenExpTimeInSec := 30
+
+	methods := methodgrpcutilpkg.Methods{
+		{FullMethod: "/auth.v1.AuthService/SignUp"},
+		{FullMethod: "/auth.v1.AuthService/RefreshToken", Auth: routehttputilpkg.AuthTokenRenewal},
+		{FullMethod: "/auth.v1.AuthService/SignOut", Auth: routehttputilpkg.AuthToken},
+	}
+
+	fullMethod := ""
+
+	authHeaderString := ""
+
+	tokenString := ""
+
+	token := &jwt.Token{}
+
+	auth := domainentity.Auth{}
+
+	authN := new(mockauthpkg.Auth)
+
+	code := codes.OK
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInInterceptingACallOfAMethodThatRequiresAToken",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignOut"
+
+				tokenString = fake.Word()
+				authHeaderString = "Bearer " + tokenString
+
+				args := map[string]interface{}{
+					"id":     uuid.NewV4(),
+					"userID": uuid.NewV4(),
+				}
+
+				auth = domainentity.AuthFactory(args)
+
+				authN = new(mockauthpkg.Auth)
+				authN.On("ExtractTokenString", authHeaderString).Return(tokenString, nil)
+				authN.On("DecodeToken", tokenString).Return(token, nil)
+				authN.On("FetchAuthFromToken", token).Return(auth, nil)
+
+				persistentAuth := persistententity.AuthFactory(args)
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "created_at"}).
+					AddRow(persistentAuth.ID, persistentAuth.UserID, persistentAuth.CreatedAt)
+
+				sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "auths" WHERE id=$1`)).
+					WithArgs(auth.ID).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInInterceptingACallOfAMethodThatRequiresATokenRenewal",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/RefreshToken"
+
+				tokenString = fake.Word()
+				authHeaderString = "Bearer " + tokenString
+
+				args := map[string]interface{}{
+					"id":     uuid.NewV4(),
+					"userID": uuid.NewV4(),
+				}
+
+				auth = domainentity.AuthFactory(args)
+
+				authN = new(mockauthpkg.Auth)
+				authN.On("ExtractTokenString", authHeaderString).Return(tokenString, nil)
+				authN.On("DecodeToken", tokenString).Return(token, nil)
+				authN.On("ValidateTokenRenewal", token, timeBeforeTokenExpTimeInSec).Return(token, nil)
+				authN.On("FetchAuthFromToken", token).Return(auth, nil)
+
+				persistentAuth := persistententity.AuthFactory(args)
+
+				rows := sqlmock.
+					NewRows([]string{"id", "user_id", "created_at"}).
+					AddRow(persistentAuth.ID, persistentAuth.UserID, persistentAuth.CreatedAt)
+
+				sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "auths" WHERE id=$1`)).
+					WithArgs(auth.ID).
+					WillReturnRows(rows)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLettingACallOfAPublicMethodPassThrough",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignUp"
+				authHeaderString = ""
+				auth = domainentity.Auth{}
+				authN = new(mockauthpkg.Auth)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheTokenIsNotInformed",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignOut"
+				authHeaderString = ""
+
+				authN = new(mockauthpkg.Auth)
+				authN.On("ExtractTokenString", authHeaderString).
+					Return("", customerror.BadRequest.New("the auth header must be informed along with the token"))
+
+				code = codes.InvalidArgument
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheMethodIsNotDeclared",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/DeleteAccount"
+				authHeaderString = ""
+				authN = new(mockauthpkg.Auth)
+
+				code = codes.PermissionDenied
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheTokenIsNotDecoded",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignOut"
+
+				tokenString = fake.Word()
+				authHeaderString = "Bearer " + tokenString
+
+				authN = new(mockauthpkg.Auth)
+				authN.On("ExtractTokenString", authHeaderString).Return(tokenString, nil)
+				authN.On("DecodeToken", tokenString).Return(nil, customerror.New("failed"))
+
+				code = codes.Unauthenticated
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			ctx := context.Background()
+			if authHeaderString != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authinterceptorpkg.MetadataKey, authHeaderString))
+			}
+
+			returnedAuth := domainentity.Auth{}
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				returnedAuth, _ = authmiddlewarepkg.FromContext(ctx)
+				return "ok", nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+
+			resp, err := authinterceptorpkg.Auth(db, authN, timeBeforeTokenExpTimeInSec, methods)(ctx, nil, info, handler)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, "ok", resp)
+				assert.Equal(t, auth, returnedAuth)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, code, status.Code(err))
+			}
+
+			authN.AssertExpectations(t)
+
+			err = sqlMock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package dbtrx
+
+import (
+	"context"
+	"log/slog"
+
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// DBTrx is the function that intercepts a unary call to enable using a database transaction during it,
+// according to the transaction mode of its method. The transaction is committed when the call succeeds
+// and rolled back otherwise. It is stored in context, as the database transaction middleware of the API does.
+func DBTrx(db *gorm.DB, methods methodgrpcutilpkg.Methods) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := methods.Find(info.FullMethod)
+		if db == nil || !ok || method.Transaction != routehttputilpkg.TransactionReadWrite {
+			return handler(ctx, req)
+		}
+
+		logger := loggerpkg.FromContext(ctx)
+
+		dbTrx := db.Begin()
+
+		// The transaction is rolled back without recovering from a panic, so that the recovery interceptor gets it
+		// along with the stack trace of where it was raised.
+		handled := false
+
+		defer func() {
+			if !handled {
+				logger.Error("database transaction is being rolled back due to a panic")
+				dbTrx.Rollback()
+			}
+		}()
+
+		// It is necessary to set database transaction that can be used for performing operations with transaction.
+		ctx, runCommitHooks := dbtrxmiddlewarepkg.WithCommitHooks(dbtrxmiddlewarepkg.NewContext(ctx, dbTrx))
+
+		resp, err := handler(ctx, req)
+
+		handled = true
+
+		if err == nil {
+			if commitErr := dbTrx.Commit().Error; commitErr != nil {
+				logger.Error("failed to commit database transaction", slog.Any("error", commitErr))
+			} else {
+				runCommitHooks()
+			}
+		} else {
+			logger.Debug("database transaction is being rolled back due to an error", slog.Any("error", err))
+			if rollbackErr := dbTrx.Rollback().Error; rollbackErr != nil {
+				logger.Error("failed to rollback database transaction", slog.Any("error", rollbackErr))
+			}
+		}
+
+		return resp, err
+	}
+}
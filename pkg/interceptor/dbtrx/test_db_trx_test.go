@@ -0,0 +1,119 @@
+package dbtrx_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	persistententity "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/perentity"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	dbtrxinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/dbtrx"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func (ts *TestSuite) TestDBTrx() {
+	user := domainentity.UserFactory(nil)
+
+	driver := "postgres"
+	db, mock := NewMockDB(driver)
+
+	methods := methodgrpcutilpkg.Methods{
+		{FullMethod: "/auth.v1.AuthService/SignUp", Transaction: routehttputilpkg.TransactionReadWrite},
+		{FullMethod: "/auth.v1.AuthService/SignOut", Auth: routehttputilpkg.AuthToken},
+	}
+
+	sqlQuery := `INSERT INTO "users" ("id","username","created_at","updated_at") VALUES ($1,$2,$3,$4)`
+
+	fullMethod := ""
+
+	var handler grpc.UnaryHandler
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCommittingTheTransactionIfTheCallSucceeds",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignUp"
+
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					dbTrx, _ := dbtrxmiddlewarepkg.FromContext(ctx)
+
+					persistentUser := persistententity.User{
+						Username: user.Username,
+					}
+
+					if result := dbTrx.Create(&persistentUser); result.Error != nil {
+						return nil, result.Error
+					}
+
+					return "ok", nil
+				}
+
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
+					WithArgs(sqlmock.AnyArg(), user.Username, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLettingACallOfAMethodWithoutTransactionPassThrough",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignOut"
+
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					if _, ok := dbtrxmiddlewarepkg.FromContext(ctx); ok {
+						return nil, customerror.New("the db_trx value is not expected in the request context")
+					}
+
+					return "ok", nil
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailAndRollBackTheTransactionIfTheCallFails",
+			SetUp: func(t *testing.T) {
+				fullMethod = "/auth.v1.AuthService/SignUp"
+
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, customerror.Conflict.New("failed")
+				}
+
+				mock.ExpectBegin()
+
+				mock.ExpectRollback()
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+
+			resp, err := dbtrxinterceptorpkg.DBTrx(db, methods)(context.Background(), nil, info, handler)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, "ok", resp)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+
+			err = mock.ExpectationsWereMet()
+			assert.Nil(t, err, fmt.Sprintf("There were unfulfilled expectations: %v.", err))
+		})
+	}
+}
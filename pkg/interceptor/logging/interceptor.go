@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// level is the function that gets the level of the log of a call from its status code.
+func level(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented, codes.DeadlineExceeded:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
+
+// Logging is the function that intercepts a unary call to attach a call-scoped logger to its context
+// and to log its outcome.
+func Logging(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		args := []any{
+			slog.String("method", info.FullMethod),
+		}
+
+		if requestID, ok := requestidmiddlewarepkg.FromContext(ctx); ok {
+			args = append(args, slog.String("request_id", requestID))
+		}
+
+		ctx = loggerpkg.NewContext(ctx, logger.With(args...))
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+
+		loggerpkg.FromContext(ctx).Log(ctx, level(code), "request completed",
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,108 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	logginginterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/logging"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (ts *TestSuite) TestLogging() {
+	fullMethod := "/auth.v1.AuthService/SignIn"
+
+	requestID := "request-id"
+
+	var handler grpc.UnaryHandler
+
+	level := ""
+
+	code := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLoggingASuccessfulCall",
+			SetUp: func(t *testing.T) {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					loggerpkg.FromContext(ctx).Info("handling")
+					return "ok", nil
+				}
+				level = "INFO"
+				code = codes.OK.String()
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLoggingAFailedCallAsAWarning",
+			SetUp: func(t *testing.T) {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					loggerpkg.FromContext(ctx).Info("handling")
+					return nil, status.Error(codes.Unauthenticated, "failed")
+				}
+				level = "WARN"
+				code = codes.Unauthenticated.String()
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldSucceedInLoggingACallThatFailedInternallyAsAnError",
+			SetUp: func(t *testing.T) {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					loggerpkg.FromContext(ctx).Info("handling")
+					return nil, status.Error(codes.Internal, "failed")
+				}
+				level = "ERROR"
+				code = codes.Internal.String()
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			buffer := &bytes.Buffer{}
+			logger := slog.New(slog.NewJSONHandler(buffer, nil))
+
+			ctx := requestidmiddlewarepkg.NewContext(context.Background(), requestID)
+			info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+
+			_, err := logginginterceptorpkg.Logging(logger)(ctx, nil, info, handler)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+
+			decoder := json.NewDecoder(buffer)
+
+			entries := make([]map[string]interface{}, 0)
+			for decoder.More() {
+				entry := make(map[string]interface{})
+				err := decoder.Decode(&entry)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				entries = append(entries, entry)
+			}
+
+			assert.Equal(t, 2, len(entries))
+			for _, entry := range entries {
+				assert.Equal(t, fullMethod, entry["method"])
+				assert.Equal(t, requestID, entry["request_id"])
+			}
+			assert.Equal(t, "request completed", entries[1]["msg"])
+			assert.Equal(t, level, entries[1]["level"])
+			assert.Equal(t, code, entries[1]["code"])
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	statusgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/status"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RetryAfterMetadataKey is the key of the metadata entry that carries the number of seconds to wait before retrying
+// a call that was rejected, as the Retry-After header of the API does.
+const RetryAfterMetadataKey = "retry-after"
+
+// clientIP is the function that returns the IP address of the peer that performed the call.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
+// RateLimit is the function that intercepts a unary call to throttle it with the rule of the rate limit policy
+// of its method, as the rate limit middlewares of the API do. The buckets are keyed by the name of the method,
+// which is the name of the route of the same operation, so that the calls and the requests of a client share them.
+// A policy mapped to nil is disabled, and the calls are let through when the store fails.
+func RateLimit(store ratelimitpkg.Store, rules map[string]*ratelimitmiddlewarepkg.Rule, methods methodgrpcutilpkg.Methods) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := methods.Find(info.FullMethod)
+		if !ok || method.RateLimitPolicy == "" {
+			return handler(ctx, req)
+		}
+
+		rule := rules[method.RateLimitPolicy]
+		if rule == nil {
+			return handler(ctx, req)
+		}
+
+		key := fmt.Sprintf("%s:ip:%s", method.Name(), clientIP(ctx))
+
+		if rule.KeyBy == ratelimitmiddlewarepkg.KeyByUser {
+			if auth, ok := authmiddlewarepkg.FromContext(ctx); ok {
+				key = fmt.Sprintf("%s:user:%s", method.Name(), auth.UserID.String())
+			}
+		}
+
+		result, err := store.Take(ctx, key, rule.Policy)
+		if err != nil {
+			loggerpkg.FromContext(ctx).Warn("failed to apply the rate limit", slog.Any("error", err))
+			return handler(ctx, req)
+		}
+
+		if !result.Allowed {
+			retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, strconv.Itoa(retryAfter)))
+
+			return nil, statusgrpcutilpkg.Error(ctx, customerror.TooManyRequests.New("too many requests, try again later"))
+		}
+
+		return handler(ctx, req)
+	}
+}
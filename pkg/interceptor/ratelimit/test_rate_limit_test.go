@@ -0,0 +1,201 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	methodgrpcutilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/grpcutil/method"
+	ratelimitinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/ratelimit"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(ctx context.Context, key string, policy ratelimitpkg.Policy) (ratelimitpkg.Result, error) {
+	return ratelimitpkg.Result{}, errors.New("failed")
+}
+
+func (failingStore) Close() error {
+	return nil
+}
+
+func (ts *TestSuite) TestRateLimit() {
+	policy := ratelimitpkg.Policy{Limit: 2, Period: time.Minute, Burst: 2}
+
+	methods := methodgrpcutilpkg.Methods{
+		{FullMethod: "/auth.v1.AuthService/SignIn", RateLimitPolicy: "sign_in"},
+		{FullMethod: "/auth.v1.AuthService/ChangePassword", RateLimitPolicy: "change_password"},
+		{FullMethod: "/auth.v1.AuthService/SignOut"},
+	}
+
+	var store ratelimitpkg.Store
+
+	rules := map[string]*ratelimitmiddlewarepkg.Rule{}
+
+	fullMethod := ""
+
+	contexts := make([]context.Context, 0)
+
+	newContext := func(ip string, userID uuid.UUID) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+		if userID != uuid.Nil {
+			ctx = authmiddlewarepkg.NewContext(ctx, domainentity.Auth{UserID: userID})
+		}
+		return ctx
+	}
+
+	userID := uuid.NewV4()
+
+	rejections := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingTheCallsOfAMethodWithoutPolicyPassThrough",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"sign_in": {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				fullMethod = "/auth.v1.AuthService/SignOut"
+				contexts = []context.Context{
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+				}
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLettingTheCallsOfAMethodWhosePolicyIsDisabledPassThrough",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"sign_in": nil,
+				}
+				fullMethod = "/auth.v1.AuthService/SignIn"
+				contexts = []context.Context{
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+				}
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInAllowingTheCallsWithinTheLimit",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"sign_in": {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				fullMethod = "/auth.v1.AuthService/SignIn"
+				contexts = []context.Context{
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.2", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+				}
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLettingTheCallsPassThroughIfTheStoreFails",
+			SetUp: func(t *testing.T) {
+				store = failingStore{}
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"sign_in": {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				fullMethod = "/auth.v1.AuthService/SignIn"
+				contexts = []context.Context{
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+				}
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheCallsOfAnIPAddressExceedTheLimit",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"sign_in": {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				fullMethod = "/auth.v1.AuthService/SignIn"
+				contexts = []context.Context{
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+					newContext("192.0.2.1", uuid.Nil),
+				}
+				rejections = 1
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheCallsOfAUserExceedTheLimitFromDifferentIPAddresses",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]*ratelimitmiddlewarepkg.Rule{
+					"change_password": {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByUser},
+				}
+				fullMethod = "/auth.v1.AuthService/ChangePassword"
+				contexts = []context.Context{
+					newContext("192.0.2.1", userID),
+					newContext("192.0.2.2", userID),
+					newContext("192.0.2.3", userID),
+				}
+				rejections = 1
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+
+			interceptor := ratelimitinterceptorpkg.RateLimit(store, rules, methods)
+
+			returnedRejections := 0
+
+			for _, ctx := range contexts {
+				resp, err := interceptor(ctx, nil, info, handler)
+				if err != nil {
+					assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+					assert.Nil(t, resp)
+					returnedRejections++
+					continue
+				}
+
+				assert.Equal(t, "ok", resp)
+			}
+
+			if !tc.WantError {
+				assert.Equal(t, 0, returnedRejections)
+			} else {
+				assert.Equal(t, rejections, returnedRejections)
+			}
+		})
+	}
+}
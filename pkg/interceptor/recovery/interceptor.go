@@ -0,0 +1,61 @@
+package recovery
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoverPanic is the function that logs a panic along with its stack trace and returns the status the client receives.
+func recoverPanic(ctx context.Context, fullMethod string, rec interface{}) error {
+	metricspkg.GRPCPanicsTotal.WithLabelValues(fullMethod).Inc()
+
+	args := []any{
+		slog.Any("panic", rec),
+		slog.String("stack", string(debug.Stack())),
+		slog.String("method", fullMethod),
+	}
+
+	if requestID, ok := requestidmiddlewarepkg.FromContext(ctx); ok {
+		args = append(args, slog.String("request_id", requestID))
+	}
+
+	loggerpkg.FromContext(ctx).Error("panic recovered", args...)
+
+	return status.Error(codes.Internal, "an unexpected error occurred")
+}
+
+// Recovery is the function that intercepts a unary call to recover from a panic raised by the next handlers,
+// so that it is logged along with its stack trace and the client receives an internal error.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				resp, err = nil, recoverPanic(ctx, info.FullMethod, rec)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery is the function that intercepts a streaming call to recover from a panic raised by the next handlers,
+// since a panic that is not recovered stops the server.
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				err = recoverPanic(ss.Context(), info.FullMethod, rec)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
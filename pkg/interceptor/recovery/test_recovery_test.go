@@ -0,0 +1,71 @@
+package recovery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	recoveryinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/recovery"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (ts *TestSuite) TestRecovery() {
+	fullMethod := "/user.v1.UserService/GetAllUsers"
+
+	var handler grpc.UnaryHandler
+
+	panicsCount := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingACallWithoutPanicPassThrough",
+			SetUp: func(t *testing.T) {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return "ok", nil
+				}
+				panicsCount = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAnInternalErrorIfThePanicHappens",
+			SetUp: func(t *testing.T) {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					panic("failed")
+				}
+				panicsCount = 1
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			panicsBefore := testutil.ToFloat64(metricspkg.GRPCPanicsTotal.WithLabelValues(fullMethod))
+
+			info := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+
+			resp, err := recoveryinterceptorpkg.Recovery()(context.Background(), nil, info, handler)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, "ok", resp)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Nil(t, resp)
+				assert.Equal(t, codes.Internal, status.Code(err))
+				assert.Equal(t, "an unexpected error occurred", status.Convert(err).Message())
+			}
+
+			panicsAfter := testutil.ToFloat64(metricspkg.GRPCPanicsTotal.WithLabelValues(fullMethod))
+			assert.Equal(t, float64(panicsCount), panicsAfter-panicsBefore)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package recovery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	recoveryinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/recovery"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serverStream is the stream of a call that only carries its context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (ts *TestSuite) TestStreamRecovery() {
+	fullMethod := "/grpc.health.v1.Health/Watch"
+
+	var handler grpc.StreamHandler
+
+	panicsCount := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingAStreamingCallWithoutPanicPassThrough",
+			SetUp: func(t *testing.T) {
+				handler = func(srv interface{}, stream grpc.ServerStream) error {
+					return nil
+				}
+				panicsCount = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithAnInternalErrorIfThePanicHappens",
+			SetUp: func(t *testing.T) {
+				handler = func(srv interface{}, stream grpc.ServerStream) error {
+					panic("failed")
+				}
+				panicsCount = 1
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			panicsBefore := testutil.ToFloat64(metricspkg.GRPCPanicsTotal.WithLabelValues(fullMethod))
+
+			info := &grpc.StreamServerInfo{FullMethod: fullMethod, IsServerStream: true}
+
+			err := recoveryinterceptorpkg.StreamRecovery()(nil, &serverStream{ctx: context.Background()}, info, handler)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Equal(t, codes.Internal, status.Code(err))
+			}
+
+			panicsAfter := testutil.ToFloat64(metricspkg.GRPCPanicsTotal.WithLabelValues(fullMethod))
+			assert.Equal(t, float64(panicsCount), panicsAfter-panicsBefore)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the key of the metadata entry that carries the request ID.
+var MetadataKey = strings.ToLower(requestidmiddlewarepkg.HeaderName)
+
+// fromMetadata is the function that gets the request ID informed by the client, when valid, or generates one.
+func fromMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 && requestidmiddlewarepkg.IsValid(values[0]) {
+			return values[0]
+		}
+	}
+
+	return uuid.NewV4().String()
+}
+
+// RequestID is the function that intercepts a unary call to identify it by the request ID informed by the client,
+// when valid, or by a generated one. The request ID is stored in context and echoed in the header of the response.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := fromMetadata(ctx)
+
+		// The header can only be set by a call handled by a gRPC server, which is not the case of the tests of the handlers.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, requestID))
+
+		return handler(requestidmiddlewarepkg.NewContext(ctx, requestID), req)
+	}
+}
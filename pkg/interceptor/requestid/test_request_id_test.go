@@ -0,0 +1,69 @@
+package requestid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	requestidinterceptorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/interceptor/requestid"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ts *TestSuite) TestRequestID() {
+	ctx := context.Background()
+
+	requestID := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInKeepingTheRequestIDInformedByTheClient",
+			SetUp: func(t *testing.T) {
+				requestID = "request-id"
+				ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestidinterceptorpkg.MetadataKey, requestID))
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingARequestIDIfTheOneInformedByTheClientIsNotValid",
+			SetUp: func(t *testing.T) {
+				requestID = ""
+				ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestidinterceptorpkg.MetadataKey, strings.Repeat("a", 129)))
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingARequestIDIfTheClientDoesNotInformIt",
+			SetUp: func(t *testing.T) {
+				requestID = ""
+				ctx = context.Background()
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRequestID := ""
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				returnedRequestID, _ = requestidmiddlewarepkg.FromContext(ctx)
+				return "ok", nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/auth.v1.AuthService/SignUp"}
+
+			resp, err := requestidinterceptorpkg.RequestID()(ctx, nil, info, handler)
+
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, "ok", resp)
+			if requestID != "" {
+				assert.Equal(t, requestID, returnedRequestID)
+			} else {
+				assert.True(t, requestidmiddlewarepkg.IsValid(returnedRequestID))
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+var loggerCtxKey = &contextKey{"logger"}
+
+type contextKey struct {
+	name string
+}
+
+// scope holds the logger of a request, so that the attributes added by an inner middleware
+// are also seen by the outer ones.
+type scope struct {
+	logger atomic.Pointer[slog.Logger]
+}
+
+// New is the factory function that encapsulates the implementation related to the logger.
+// The format is either json or text and the level is one of debug, info, warn or error.
+func New(w io.Writer, format string, level string) (*slog.Logger, error) {
+	var slogLevel slog.Level
+
+	if err := slogLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, customerror.Newf("the log level %s is not recognized", level)
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: slogLevel,
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, customerror.Newf("the log format %s is not recognized", format)
+	}
+}
+
+// NewContext is the function that returns a new Context that carries the logger of a request.
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	s := &scope{}
+	s.logger.Store(logger)
+
+	return context.WithValue(ctx, loggerCtxKey, s)
+}
+
+// FromContext is the function that returns the logger stored in context, or the default logger if there is none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if s, ok := ctx.Value(loggerCtxKey).(*scope); ok {
+		return s.logger.Load()
+	}
+
+	return slog.Default()
+}
+
+// With is the function that adds attributes to the logger stored in context, if any.
+func With(ctx context.Context, args ...any) {
+	if s, ok := ctx.Value(loggerCtxKey).(*scope); ok {
+		s.logger.Store(s.logger.Load().With(args...))
+	}
+}
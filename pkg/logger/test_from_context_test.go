@@ -0,0 +1,48 @@
+package logger_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestFromContext() {
+	logger := &slog.Logger{}
+
+	ctx := context.Background()
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingTheLoggerFromAContext",
+			SetUp: func(t *testing.T) {
+				logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+				ctx = loggerpkg.NewContext(context.Background(), logger)
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGettingTheDefaultLoggerFromAContextWithoutLogger",
+			SetUp: func(t *testing.T) {
+				logger = slog.Default()
+				ctx = context.Background()
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedLogger := loggerpkg.FromContext(ctx)
+
+			if !tc.WantError {
+				assert.Equal(t, logger, returnedLogger)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package logger_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestNew() {
+	format := ""
+	level := ""
+
+	output := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCreatingAJSONLogger",
+			SetUp: func(t *testing.T) {
+				format = "json"
+				level = "info"
+				output = `"msg":"testing"`
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCreatingATextLogger",
+			SetUp: func(t *testing.T) {
+				format = "text"
+				level = "debug"
+				output = "msg=testing"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCreatingALoggerThatDiscardsLowerLevels",
+			SetUp: func(t *testing.T) {
+				format = "json"
+				level = "warn"
+				output = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheFormatIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				format = "xml"
+				level = "info"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheLevelIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				format = "json"
+				level = "verbose"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			buffer := bytes.Buffer{}
+
+			logger, err := loggerpkg.New(&buffer, format, level)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.NotNil(t, logger)
+				logger.Info("testing")
+				if output != "" {
+					assert.Contains(t, buffer.String(), output)
+				} else {
+					assert.Empty(t, buffer.String())
+				}
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Nil(t, logger)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestWith() {
+	buffer := bytes.Buffer{}
+
+	ctx := context.Background()
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAddingAttributesToTheLoggerOfAContext",
+			SetUp: func(t *testing.T) {
+				buffer.Reset()
+				logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+				ctx = loggerpkg.NewContext(context.Background(), logger)
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			// The attributes added through a derived context must be seen through the original one as well.
+			innerCtx := context.WithValue(ctx, struct{}{}, "inner")
+			loggerpkg.With(innerCtx, slog.String("user_id", "testing"))
+
+			loggerpkg.FromContext(ctx).Info("testing")
+
+			if !tc.WantError {
+				assert.Contains(t, buffer.String(), `"user_id":"testing"`)
+			}
+		})
+	}
+}
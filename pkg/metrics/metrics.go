@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "api"
+
+// Registry is the registry that gathers the application metrics along with the Go runtime and process ones.
+var Registry = newRegistry()
+
+var (
+	// HTTPRequestsTotal counts the handled requests by route name, method and status code.
+	HTTPRequestsTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests handled by route, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	// HTTPRequestDuration observes the latency of the handled requests by route name and method.
+	HTTPRequestDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests in seconds by route and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	// HTTPPanicsTotal counts the panics recovered while handling requests by route name.
+	HTTPPanicsTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "panics_total",
+		Help:      "Total number of panics recovered while handling HTTP requests by route.",
+	}, []string{"route"})
+
+	// HTTPRateLimitedTotal counts the requests rejected for exceeding the rate limit by route name.
+	HTTPRateLimitedTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "rate_limited_total",
+		Help:      "Total number of HTTP requests rejected for exceeding the rate limit by route.",
+	}, []string{"route"})
+
+	// HTTPDeprecatedRequestsTotal counts the requests to deprecated routes by route name and version,
+	// so that it is known when a route can be removed.
+	HTTPDeprecatedRequestsTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "deprecated_requests_total",
+		Help:      "Total number of HTTP requests to deprecated routes by route and version.",
+	}, []string{"route", "version"})
+
+	// GRPCPanicsTotal counts the panics recovered while handling gRPC calls by method.
+	GRPCPanicsTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "panics_total",
+		Help:      "Total number of panics recovered while handling gRPC calls by method.",
+	}, []string{"method"})
+
+	// SignUpsTotal counts the users registered to the system.
+	SignUpsTotal = promauto.With(Registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "sign_ups_total",
+		Help:      "Total number of users registered.",
+	})
+
+	// SignInsTotal counts the attempts to log in by result and, in case of failure, its reason.
+	SignInsTotal = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "sign_ins_total",
+		Help:      "Total number of sign-in attempts by result and failure reason.",
+	}, []string{"result", "reason"})
+
+	// TokenRenewalsTotal counts the renewed tokens.
+	TokenRenewalsTotal = promauto.With(Registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "token_renewals_total",
+		Help:      "Total number of tokens renewed.",
+	})
+
+	// SignOutsTotal counts the users logged out of the system.
+	SignOutsTotal = promauto.With(Registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "sign_outs_total",
+		Help:      "Total number of users logged out.",
+	})
+
+	// PasswordHashingDuration observes the time spent on hashing passwords with bcrypt.
+	PasswordHashingDuration = promauto.With(Registry).NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "security",
+		Name:      "password_hashing_duration_seconds",
+		Help:      "Time spent on hashing passwords with bcrypt in seconds.",
+		Buckets:   []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	})
+)
+
+// The reasons of a failed sign-in attempt.
+const (
+	SignInReasonInvalidInput    = "invalid_input"
+	SignInReasonUnknownUser     = "unknown_user"
+	SignInReasonInvalidPassword = "invalid_password"
+	SignInReasonAlreadyLoggedIn = "already_logged_in"
+	SignInReasonInternalError   = "internal_error"
+)
+
+// CountSignIn is the function that counts a sign-in attempt, which is successful when no failure reason is informed.
+func CountSignIn(failureReason string) {
+	if failureReason == "" {
+		SignInsTotal.WithLabelValues("success", "").Inc()
+		return
+	}
+
+	SignInsTotal.WithLabelValues("failure", failureReason).Inc()
+}
+
+func newRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return registry
+}
+
+// RegisterDBStats is the function that registers the statistics of the database connection pool.
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Handler is the function that returns the handler that exposes the metrics in Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,49 @@
+package metrics_test
+
+import (
+	"testing"
+
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestCountSignIn() {
+	failureReason := ""
+
+	result := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCountingASuccessfulSignIn",
+			SetUp: func(t *testing.T) {
+				failureReason = ""
+				result = "success"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCountingAFailedSignInByItsReason",
+			SetUp: func(t *testing.T) {
+				failureReason = metricspkg.SignInReasonInvalidPassword
+				result = "failure"
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			counter := metricspkg.SignInsTotal.WithLabelValues(result, failureReason)
+			count := testutil.ToFloat64(counter)
+
+			metricspkg.CountSignIn(failureReason)
+
+			if !tc.WantError {
+				assert.Equal(t, count+1, testutil.ToFloat64(counter))
+			}
+		})
+	}
+}
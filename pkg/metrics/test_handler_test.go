@@ -0,0 +1,39 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestHandler() {
+	ts.Cases = Cases{
+		{
+			Context:   "ItShouldSucceedInExposingTheMetricsInPrometheusFormat",
+			SetUp:     func(t *testing.T) {},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			metricspkg.SignUpsTotal.Inc()
+
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			resprec := httptest.NewRecorder()
+
+			metricspkg.Handler().ServeHTTP(resprec, req)
+
+			if !tc.WantError {
+				assert.Equal(t, http.StatusOK, resprec.Result().StatusCode)
+				assert.Contains(t, resprec.Body.String(), "api_auth_sign_ups_total")
+				assert.Contains(t, resprec.Body.String(), "go_goroutines")
+			}
+		})
+	}
+}
@@ -2,16 +2,23 @@ package auth
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 
 	"github.com/golang-jwt/jwt"
 	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
 	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	datastorepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/datastore"
 	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
 	"gorm.io/gorm"
 )
 
+// CodeNotLoggedIn is the code of the error returned when the auth of the token was already logged out.
+const CodeNotLoggedIn = "NOT_LOGGED_IN"
+
 var authDetailsCtxKey = &contextKey{"auth_details"}
 
 type contextKey struct {
@@ -29,7 +36,12 @@ func FromContext(ctx context.Context) (domainentity.Auth, bool) {
 	return raw, ok
 }
 
-func buildAuth(db *gorm.DB, authN authpkg.IAuth, token *jwt.Token) (domainentity.Auth, error) {
+func buildAuth(ctx context.Context, db *gorm.DB, authN authpkg.IAuth, token *jwt.Token) (domainentity.Auth, error) {
+	ctx, span := tracingpkg.Start(ctx, "AuthMiddleware.buildAuth")
+	defer span.End()
+
+	db = db.WithContext(ctx)
+
 	auth, err := authN.FetchAuthFromToken(token)
 	if err != nil {
 		return domainentity.Auth{}, err
@@ -37,47 +49,81 @@ func buildAuth(db *gorm.DB, authN authpkg.IAuth, token *jwt.Token) (domainentity
 
 	// Before proceeding is necessary to check if the user who is performing operations is logged
 	// based on the authentication details inserted within in the token.
+	// The lookup is sent to the primary, since the auth may have just been created and not yet replicated.
 	authAux := domainentity.Auth{}
 
-	result := db.Find(&authAux, "id=?", auth.ID)
+	result := datastorepkg.UsePrimary(db).Find(&authAux, "id=?", auth.ID)
 	if result.Error != nil {
-		return domainentity.Auth{}, result.Error
+		return domainentity.Auth{}, datastorepkg.TranslateError(result.Error)
 	}
 
 	if authAux.IsEmpty() {
 		errorMessage := "you are not logged in, then perform a login to get a token before proceeding"
-		return domainentity.Auth{}, customerror.BadRequest.New(errorMessage)
+		return domainentity.Auth{}, customerror.WithCode(customerror.BadRequest.New(errorMessage), CodeNotLoggedIn)
 	}
 
 	if auth.UserID.String() != authAux.UserID.String() {
 		errorMessage := "the token's auth_id and user_id are not associated"
-		return domainentity.Auth{}, customerror.BadRequest.New(errorMessage)
+		return domainentity.Auth{}, customerror.WithCode(customerror.BadRequest.New(errorMessage), authpkg.CodeTokenInvalid)
 	}
 
 	return auth, nil
 }
 
+// unauthorized is the function that turns the error of a token into an unauthorized error, keeping its code.
+func unauthorized(err error) error {
+	code := customerror.GetCode(err)
+	if code == "" {
+		code = authpkg.CodeTokenInvalid
+	}
+
+	return customerror.WithCode(customerror.Unauthorized.New(err.Error()), code)
+}
+
+// Authenticate is the function that evaluates the authentication based on the JWT token of an authorization header,
+// so that the auth details of the logged in user are returned.
+func Authenticate(ctx context.Context, db *gorm.DB, authN authpkg.IAuth, authHeaderString string) (domainentity.Auth, error) {
+	tokenString, err := authN.ExtractTokenString(authHeaderString)
+	if err != nil {
+		return domainentity.Auth{}, err
+	}
+
+	token, err := authN.DecodeToken(tokenString)
+	if err != nil {
+		return domainentity.Auth{}, unauthorized(err)
+	}
+
+	return buildAuth(ctx, db, authN, token)
+}
+
+// AuthenticateRenewal is the function that evaluates the authentication renewal based on the JWT token of an authorization header,
+// so that the auth details of the logged in user are returned when the token is about to expire.
+func AuthenticateRenewal(ctx context.Context, db *gorm.DB, authN authpkg.IAuth, authHeaderString string, timeBeforeTokenExpTimeInSec int) (domainentity.Auth, error) {
+	tokenString, err := authN.ExtractTokenString(authHeaderString)
+	if err != nil {
+		return domainentity.Auth{}, err
+	}
+
+	token, err := authN.DecodeToken(tokenString)
+	if err != nil {
+		return domainentity.Auth{}, unauthorized(err)
+	}
+
+	token, err = authN.ValidateTokenRenewal(token, timeBeforeTokenExpTimeInSec)
+	if err != nil {
+		return domainentity.Auth{}, unauthorized(err)
+	}
+
+	return buildAuth(ctx, db, authN, token)
+}
+
 // Auth is the function that wraps a http.Handler to evaluate the authentication of API based on a JWT token.
 func Auth(db *gorm.DB, authN authpkg.IAuth) func(http.HandlerFunc) http.HandlerFunc {
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
-			authHeaderString := r.Header.Get("Authorization")
-
-			tokenString, err := authN.ExtractTokenString(authHeaderString)
-			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, err)
-				return
-			}
-
-			token, err := authN.DecodeToken(tokenString)
+			auth, err := Authenticate(r.Context(), db, authN, r.Header.Get("Authorization"))
 			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, customerror.Unauthorized.New(err.Error()))
-				return
-			}
-
-			auth, err := buildAuth(db, authN, token)
-			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, err)
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 				return
 			}
 
@@ -85,6 +131,8 @@ func Auth(db *gorm.DB, authN authpkg.IAuth) func(http.HandlerFunc) http.HandlerF
 			ctx := NewContext(r.Context(), auth)
 			r = r.WithContext(ctx)
 
+			loggerpkg.With(ctx, slog.String("user_id", auth.UserID.String()), slog.String("auth_id", auth.ID.String()))
+
 			next.ServeHTTP(w, r)
 		}
 	}
@@ -94,29 +142,9 @@ func Auth(db *gorm.DB, authN authpkg.IAuth) func(http.HandlerFunc) http.HandlerF
 func AuthRenewal(db *gorm.DB, authN authpkg.IAuth, timeBeforeTokenExpTimeInSec int) func(http.HandlerFunc) http.HandlerFunc {
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
-			authHeaderString := r.Header.Get("Authorization")
-
-			tokenString, err := authN.ExtractTokenString(authHeaderString)
+			auth, err := AuthenticateRenewal(r.Context(), db, authN, r.Header.Get("Authorization"), timeBeforeTokenExpTimeInSec)
 			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, err)
-				return
-			}
-
-			token, err := authN.DecodeToken(tokenString)
-			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, customerror.Unauthorized.New(err.Error()))
-				return
-			}
-
-			token, err = authN.ValidateTokenRenewal(token, timeBeforeTokenExpTimeInSec)
-			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, customerror.Unauthorized.New(err.Error()))
-				return
-			}
-
-			auth, err := buildAuth(db, authN, token)
-			if err != nil {
-				responsehttputilpkg.RespondErrorWithJSON(w, err)
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
 				return
 			}
 
@@ -124,6 +152,8 @@ func AuthRenewal(db *gorm.DB, authN authpkg.IAuth, timeBeforeTokenExpTimeInSec i
 			ctx := NewContext(r.Context(), auth)
 			r = r.WithContext(ctx)
 
+			loggerpkg.With(ctx, slog.String("user_id", auth.UserID.String()), slog.String("auth_id", auth.ID.String()))
+
 			next.ServeHTTP(w, r)
 		}
 	}
@@ -0,0 +1,29 @@
+package bodylimit
+
+import (
+	"net/http"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+)
+
+// BodyLimit is the function that wraps a http.Handler to limit the size of the request body.
+// A request that declares a larger body is rejected right away, while the body of the other ones is
+// capped so that reading past the limit fails with a *http.MaxBytesError.
+func BodyLimit(maxBytes int64) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				responsehttputilpkg.RespondErrorWithJSON(w, r,
+					customerror.WithDetails(customerror.RequestEntityTooLarge.Newf("the request body must not be larger than %d bytes", maxBytes), "max_bytes", maxBytes))
+				return
+			}
+
+			if r.Body != nil {
+				r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			}
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
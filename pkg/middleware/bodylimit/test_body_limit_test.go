@@ -0,0 +1,78 @@
+package bodylimit_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	bodylimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/bodylimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestBodyLimit() {
+	maxBytes := int64(32)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		payload := map[string]interface{}{}
+		if err := requesthttputilpkg.DecodeJSON(r, &payload); err != nil {
+			responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+
+	var body io.Reader
+
+	contentLength := int64(0)
+
+	statusCode := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingARequestWithinTheLimitPassThrough",
+			SetUp: func(t *testing.T) {
+				body = strings.NewReader(`{"username":"username"}`)
+				contentLength = -1
+				statusCode = http.StatusOK
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheDeclaredContentLengthIsLargerThanTheLimit",
+			SetUp: func(t *testing.T) {
+				body = strings.NewReader(`{"username":"` + strings.Repeat("a", 64) + `"}`)
+				contentLength = 78
+				statusCode = http.StatusRequestEntityTooLarge
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheBodyIsLargerThanTheLimitWithoutDeclaringItsLength",
+			SetUp: func(t *testing.T) {
+				body = strings.NewReader(`{"username":"` + strings.Repeat("a", 64) + `"}`)
+				contentLength = -1
+				statusCode = http.StatusRequestEntityTooLarge
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/sign_in", body)
+			req.ContentLength = contentLength
+
+			resprec := httptest.NewRecorder()
+
+			bodylimitmiddlewarepkg.BodyLimit(maxBytes)(handler).ServeHTTP(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package clientcert
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+)
+
+var identityCtxKey = &contextKey{"client_cert_identity"}
+
+type contextKey struct {
+	name string
+}
+
+// Identity is the identity of the caller taken from its verified client certificate.
+type Identity struct {
+	CommonName     string
+	Organizations  []string
+	DNSNames       []string
+	EmailAddresses []string
+	URIs           []string
+	SerialNumber   string
+	Fingerprint    string
+}
+
+// NewIdentity is the function that builds the identity of a client certificate.
+func NewIdentity(cert *x509.Certificate) Identity {
+	uris := make([]string, 0, len(cert.URIs))
+	for _, uri := range cert.URIs {
+		uris = append(uris, uri.String())
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	return Identity{
+		CommonName:     cert.Subject.CommonName,
+		Organizations:  cert.Subject.Organization,
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		URIs:           uris,
+		SerialNumber:   cert.SerialNumber.String(),
+		Fingerprint:    hex.EncodeToString(fingerprint[:]),
+	}
+}
+
+// NewContext is the function that returns a new Context that carries client_cert_identity value.
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityCtxKey, identity)
+}
+
+// FromContext is the function that returns the client_cert_identity value stored in context, if any.
+func FromContext(ctx context.Context) (Identity, bool) {
+	raw, ok := ctx.Value(identityCtxKey).(Identity)
+	return raw, ok
+}
+
+// ClientCert is the function that wraps a http.Handler to store the identity of the client certificate in the
+// request context, so that it can be used for authorization and added to the logs. Only the certificates verified during the TLS
+// handshake are taken into account, and the requests without one are passed on unchanged.
+func ClientCert() func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			identity := NewIdentity(r.TLS.VerifiedChains[0][0])
+
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), identity)))
+		}
+	}
+}
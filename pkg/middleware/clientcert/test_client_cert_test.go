@@ -0,0 +1,93 @@
+package clientcert_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	clientcertmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/clientcert"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestClientCert() {
+	spiffeID, _ := url.Parse("spiffe://example.org/service/billing")
+
+	cert := &x509.Certificate{
+		Raw:          []byte("certificate"),
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "billing", Organization: []string{"Example"}},
+		DNSNames:     []string{"billing.internal"},
+		URIs:         []*url.URL{spiffeID},
+	}
+
+	var connState *tls.ConnectionState
+
+	found := false
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInStoringTheIdentityOfAVerifiedClientCertificate",
+			SetUp: func(t *testing.T) {
+				connState = &tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{cert},
+					VerifiedChains:   [][]*x509.Certificate{{cert}},
+				}
+				found = true
+			},
+		},
+		{
+			Context: "ItShouldSucceedInIgnoringAClientCertificateThatWasNotVerified",
+			SetUp: func(t *testing.T) {
+				connState = &tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{cert},
+				}
+				found = false
+			},
+		},
+		{
+			Context: "ItShouldSucceedInIgnoringARequestWithoutTLS",
+			SetUp: func(t *testing.T) {
+				connState = nil
+				found = false
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			identity := clientcertmiddlewarepkg.Identity{}
+			ok := false
+
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				identity, ok = clientcertmiddlewarepkg.FromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			req.TLS = connState
+
+			resprec := httptest.NewRecorder()
+
+			clientcertmiddlewarepkg.ClientCert()(handler).ServeHTTP(resprec, req)
+
+			assert.Equal(t, http.StatusOK, resprec.Result().StatusCode)
+			assert.Equal(t, found, ok)
+
+			if found {
+				assert.Equal(t, "billing", identity.CommonName)
+				assert.Equal(t, []string{"Example"}, identity.Organizations)
+				assert.Equal(t, []string{"billing.internal"}, identity.DNSNames)
+				assert.Equal(t, []string{"spiffe://example.org/service/billing"}, identity.URIs)
+				assert.Equal(t, "42", identity.SerialNumber)
+				assert.Len(t, identity.Fingerprint, 64)
+			}
+		})
+	}
+}
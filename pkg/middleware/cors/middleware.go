@@ -0,0 +1,115 @@
+package cors
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the set of settings that defines which cross-origin requests are allowed.
+type Config struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// isOriginAllowed is the function that checks if an origin is in the list of allowed origins,
+// in which the wildcard * allows any origin.
+func (c Config) isOriginAllowed(origin string) bool {
+	for _, allowedOrigin := range c.AllowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsAnyOrigin is the function that checks if the wildcard * is in the list of allowed origins.
+func (c Config) allowsAnyOrigin() bool {
+	for _, allowedOrigin := range c.AllowedOrigins {
+		if allowedOrigin == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate is the function that checks if the settings are consistent. The wildcard * cannot be combined with
+// credentials, since the origin would be echoed and any site could make credentialed requests on behalf of the user.
+func (c Config) Validate() error {
+	if c.allowsAnyOrigin() && c.AllowCredentials {
+		return errors.New("the wildcard * cannot be an allowed origin when credentials are allowed")
+	}
+
+	return nil
+}
+
+// setAllowOrigin is the function that sets the headers that allow the origin to read the response.
+// The origin is echoed instead of the wildcard when credentials are allowed, since browsers reject the wildcard then.
+func (c Config) setAllowOrigin(h http.Header, origin string) {
+	if c.allowsAnyOrigin() && !c.AllowCredentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Add("Vary", "Origin")
+	}
+
+	if c.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+// CORS is the function that wraps a http.Handler to enable the cross-origin requests of the allowed origins.
+// The preflight requests are answered right away, while the other ones are passed on with the CORS headers set.
+// The requests of origins that are not allowed are passed on without any CORS header, so that browsers block them.
+func CORS(config Config) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" || !config.isOriginAllowed(origin) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				config.setAllowOrigin(h, origin)
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+
+				h.Set("Access-Control-Allow-Methods", strings.Join(config.AllowedMethods, ", "))
+
+				if len(config.AllowedHeaders) == 1 && config.AllowedHeaders[0] == "*" {
+					if requestHeaders := r.Header.Get("Access-Control-Request-Headers"); requestHeaders != "" {
+						h.Set("Access-Control-Allow-Headers", requestHeaders)
+					}
+				} else if len(config.AllowedHeaders) > 0 {
+					h.Set("Access-Control-Allow-Headers", strings.Join(config.AllowedHeaders, ", "))
+				}
+
+				if config.MaxAge > 0 {
+					h.Set("Access-Control-Max-Age", strconv.Itoa(int(config.MaxAge.Seconds())))
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			config.setAllowOrigin(h, origin)
+
+			if len(config.ExposedHeaders) > 0 {
+				h.Set("Access-Control-Expose-Headers", strings.Join(config.ExposedHeaders, ", "))
+			}
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
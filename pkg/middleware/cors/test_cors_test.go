@@ -0,0 +1,142 @@
+package cors_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corsmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/cors"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestCORS() {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	config := corsmiddlewarepkg.Config{}
+
+	var req *http.Request
+
+	statusCode := 0
+
+	headers := map[string]string{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingARequestWithoutOriginPassThrough",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"https://app.example.com"}}
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				statusCode = http.StatusOK
+				headers = map[string]string{
+					"Access-Control-Allow-Origin": "",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInLettingARequestOfAnOriginThatIsNotAllowedPassThroughWithoutCORSHeaders",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"https://app.example.com"}}
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				req.Header.Set("Origin", "https://evil.example.com")
+				statusCode = http.StatusOK
+				headers = map[string]string{
+					"Access-Control-Allow-Origin": "",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSettingTheCORSHeadersOfARequestOfAnAllowedOrigin",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{
+					AllowedOrigins:   []string{"https://app.example.com"},
+					ExposedHeaders:   []string{"ETag", "X-Request-ID"},
+					AllowCredentials: true,
+				}
+				req = httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+				req.Header.Set("Origin", "https://app.example.com")
+				statusCode = http.StatusOK
+				headers = map[string]string{
+					"Access-Control-Allow-Origin":      "https://app.example.com",
+					"Access-Control-Allow-Credentials": "true",
+					"Access-Control-Expose-Headers":    "ETag, X-Request-ID",
+					"Vary":                             "Origin",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSettingTheWildcardOriginIfAnyOriginIsAllowedWithoutCredentials",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"*"}}
+				req = httptest.NewRequest(http.MethodGet, "/status", nil)
+				req.Header.Set("Origin", "https://app.example.com")
+				statusCode = http.StatusOK
+				headers = map[string]string{
+					"Access-Control-Allow-Origin":      "*",
+					"Access-Control-Allow-Credentials": "",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAnsweringAPreflightRequestOfAnAllowedOrigin",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{
+					AllowedOrigins:   []string{"https://app.example.com"},
+					AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+					AllowedHeaders:   []string{"Authorization", "Content-Type"},
+					AllowCredentials: true,
+					MaxAge:           10 * time.Minute,
+				}
+				req = httptest.NewRequest(http.MethodOptions, "/sign_in", nil)
+				req.Header.Set("Origin", "https://app.example.com")
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "content-type")
+				statusCode = http.StatusNoContent
+				headers = map[string]string{
+					"Access-Control-Allow-Origin":      "https://app.example.com",
+					"Access-Control-Allow-Methods":     "GET, POST",
+					"Access-Control-Allow-Headers":     "Authorization, Content-Type",
+					"Access-Control-Allow-Credentials": "true",
+					"Access-Control-Max-Age":           "600",
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInEchoingTheRequestedHeadersIfAnyHeaderIsAllowed",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{
+					AllowedOrigins: []string{"https://app.example.com"},
+					AllowedMethods: []string{http.MethodPost},
+					AllowedHeaders: []string{"*"},
+				}
+				req = httptest.NewRequest(http.MethodOptions, "/sign_in", nil)
+				req.Header.Set("Origin", "https://app.example.com")
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "content-type, x-custom")
+				statusCode = http.StatusNoContent
+				headers = map[string]string{
+					"Access-Control-Allow-Headers": "content-type, x-custom",
+					"Access-Control-Max-Age":       "",
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			resprec := httptest.NewRecorder()
+
+			corsmiddlewarepkg.CORS(config)(handler).ServeHTTP(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+
+			for key, value := range headers {
+				assert.Equal(t, value, resprec.Header().Get(key), key)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cors_test
+
+import (
+	"fmt"
+	"testing"
+
+	corsmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/cors"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestValidate() {
+	config := corsmiddlewarepkg.Config{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInValidatingTheWildcardWithoutCredentials",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"*"}}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInValidatingTheListedOriginsWithCredentials",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"https://app.example.com"}, AllowCredentials: true}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheWildcardIsCombinedWithCredentials",
+			SetUp: func(t *testing.T) {
+				config = corsmiddlewarepkg.Config{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			err := config.Validate()
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
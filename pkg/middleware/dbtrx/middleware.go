@@ -2,15 +2,18 @@ package dbtrx
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net/http"
+	"sync"
 
-	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
 	"gorm.io/gorm"
 )
 
 var dbTrxCtxKey = &contextKey{"db_trx"}
 
+var commitHooksCtxKey = &contextKey{"commit_hooks"}
+
 type contextKey struct {
 	name string
 }
@@ -38,6 +41,45 @@ func FromContext(ctx context.Context) (*gorm.DB, bool) {
 	return raw, ok
 }
 
+// commitHooks gathers the functions to be called once a database transaction is committed.
+type commitHooks struct {
+	mu    sync.Mutex
+	funcs []func()
+}
+
+func (h *commitHooks) run() {
+	h.mu.Lock()
+	funcs := h.funcs
+	h.funcs = nil
+	h.mu.Unlock()
+
+	for _, f := range funcs {
+		f()
+	}
+}
+
+// WithCommitHooks is the function that returns a new Context that gathers the functions registered with AfterCommit,
+// along with the function that calls them, which must be called only once the database transaction is committed.
+func WithCommitHooks(ctx context.Context) (context.Context, func()) {
+	hooks := &commitHooks{}
+	return context.WithValue(ctx, commitHooksCtxKey, hooks), hooks.run
+}
+
+// AfterCommit is the function that registers a function to be called once the database transaction of the context
+// is committed, so that it is not called at all if the transaction is rolled back. The function is called right away
+// if the context carries no database transaction to wait for.
+func AfterCommit(ctx context.Context, f func()) {
+	hooks, ok := ctx.Value(commitHooksCtxKey).(*commitHooks)
+	if !ok {
+		f()
+		return
+	}
+
+	hooks.mu.Lock()
+	hooks.funcs = append(hooks.funcs, f)
+	hooks.mu.Unlock()
+}
+
 func wrapResponseWriter(w http.ResponseWriter) *responseWriter {
 	return &responseWriter{ResponseWriter: w}
 }
@@ -75,40 +117,41 @@ func DBTrx(db *gorm.DB) func(http.HandlerFunc) http.HandlerFunc {
 				return
 			}
 
+			logger := loggerpkg.FromContext(r.Context())
+
 			dbTrx := db.Begin()
 
+			// The transaction is rolled back without recovering from a panic, so that the recovery middleware gets it
+			// along with the stack trace of where it was raised.
+			handled := false
+
 			defer func() {
-				if r := recover(); r != nil {
-					var err error
-					switch r := r.(type) {
-					case error:
-						err = r
-					default:
-						err = customerror.Newf("%v", r)
-					}
-					w.WriteHeader(http.StatusInternalServerError)
-					log.Printf("Transaction is being rolled back: %s \n", err.Error())
+				if !handled {
+					logger.Error("database transaction is being rolled back due to a panic")
 					dbTrx.Rollback()
-					return
 				}
 			}()
 
 			// It is necessary to set database transaction that can be used for performing operations with transaction.
-			ctx := NewContext(r.Context(), dbTrx)
+			ctx, runCommitHooks := WithCommitHooks(NewContext(r.Context(), dbTrx))
 			r = r.WithContext(ctx)
 
 			wrapped := wrapResponseWriter(w)
 
 			next.ServeHTTP(wrapped, r)
 
+			handled = true
+
 			if isStatusCodeInList(wrapped.Status(), statusCodesList) {
 				if err := dbTrx.Commit().Error; err != nil {
-					log.Printf("failed to commit database transaction: %s", err.Error())
+					logger.Error("failed to commit database transaction", slog.Any("error", err))
+				} else {
+					runCommitHooks()
 				}
 			} else {
-				log.Printf("database transaction is being rolled back due to status code: %d", wrapped.statusCode)
+				logger.Debug("database transaction is being rolled back due to status code", slog.Int("status", wrapped.statusCode))
 				if err := dbTrx.Rollback().Error; err != nil {
-					log.Printf("failed to rollback database transaction: %s", err.Error())
+					logger.Error("failed to rollback database transaction", slog.Any("error", err))
 				}
 			}
 		}
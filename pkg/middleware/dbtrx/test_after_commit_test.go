@@ -0,0 +1,107 @@
+package dbtrx_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestAfterCommit() {
+	driver := "postgres"
+	db, mock := NewMockDB(driver)
+	dbAux := &gorm.DB{}
+
+	statusCode := 0
+
+	wantCalls := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCallingTheFunctionOnceTheDatabaseTransactionIsCommitted",
+			SetUp: func(t *testing.T) {
+				dbAux = db
+
+				statusCode = http.StatusOK
+
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+
+				wantCalls = 1
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailInCallingTheFunctionIfTheDatabaseTransactionIsRolledBack",
+			SetUp: func(t *testing.T) {
+				dbAux = db
+
+				statusCode = http.StatusInternalServerError
+
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+
+				wantCalls = 0
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailInCallingTheFunctionIfTheCommitStatementToEndTheDatabaseTransactionFails",
+			SetUp: func(t *testing.T) {
+				dbAux = db
+
+				statusCode = http.StatusOK
+
+				mock.ExpectBegin()
+				mock.ExpectCommit().WillReturnError(customerror.New("failed"))
+
+				wantCalls = 0
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldSucceedInCallingTheFunctionRightAwayIfThereIsNoDatabaseTransaction",
+			SetUp: func(t *testing.T) {
+				dbAux = nil
+
+				statusCode = http.StatusOK
+
+				wantCalls = 1
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			calls := 0
+
+			handlerFunc := func(w http.ResponseWriter, r *http.Request) {
+				dbtrxmiddlewarepkg.AfterCommit(r.Context(), func() { calls++ })
+
+				if dbAux != nil {
+					assert.Equal(t, 0, calls)
+				}
+
+				responsehttputilpkg.RespondWithJSON(w, statusCode, responsehttputilpkg.Message{Text: "ok"})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/testing", nil)
+			resprec := httptest.NewRecorder()
+
+			dbtrxmiddlewarepkg.DBTrx(dbAux)(handlerFunc).ServeHTTP(resprec, req)
+
+			assert.Equal(t, wantCalls, calls)
+
+			err := mock.ExpectationsWereMet()
+			assert.Nil(t, err, "There were unfulfilled expectations.")
+		})
+	}
+}
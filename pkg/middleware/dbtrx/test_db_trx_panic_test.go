@@ -0,0 +1,93 @@
+package dbtrx_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	adapterhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/adapter"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	dbtrxmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/dbtrx"
+	recoverymiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/recovery"
+	"github.com/stretchr/testify/assert"
+)
+
+// panickingHandler is the function that panics, so that its frame is expected on top of the stack trace of the panic.
+func panickingHandler(w http.ResponseWriter, r *http.Request) {
+	panic("failed")
+}
+
+func (ts *TestSuite) TestDBTrxPanic() {
+	driver := "postgres"
+	db, mock := NewMockDB(driver)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRollingBackTheDatabaseTransactionAndLettingTheRecoveryMiddlewareLogTheStackTraceOfThePanic",
+			SetUp: func(t *testing.T) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+			returnedHandlerFunc := adapterhttputilpkg.AdaptFunc(panickingHandler).
+				With(recoverymiddlewarepkg.Recovery(), dbtrxmiddlewarepkg.DBTrx(db))
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			req = req.WithContext(loggerpkg.NewContext(req.Context(), logger))
+
+			resprec := httptest.NewRecorder()
+
+			assert.NotPanics(t, func() { returnedHandlerFunc.ServeHTTP(resprec, req) })
+
+			assert.Equal(t, http.StatusInternalServerError, resprec.Result().StatusCode)
+
+			// The stack trace of the panic is the one logged by the recovery middleware.
+			stack := ""
+
+			decoder := json.NewDecoder(&buf)
+			for decoder.More() {
+				var entry struct {
+					Msg   string `json:"msg"`
+					Stack string `json:"stack"`
+				}
+
+				err := decoder.Decode(&entry)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				if entry.Msg == "panic recovered" {
+					stack = entry.Stack
+				}
+			}
+
+			assert.NotEmpty(t, stack)
+
+			// The frame right after the one of the panic is the one where it was raised,
+			// rather than the one of the middleware if it had propagated the panic again.
+			lines := strings.Split(stack, "\n")
+			for i, line := range lines {
+				if strings.HasPrefix(line, "panic(") {
+					assert.Contains(t, lines[i+2], "panickingHandler")
+					break
+				}
+			}
+
+			err := mock.ExpectationsWereMet()
+			assert.Nil(t, err, "There were unfulfilled expectations.")
+		})
+	}
+}
@@ -81,7 +81,7 @@ func (ts *TestSuite) TestDBTrx() {
 				handlerFunc = func(w http.ResponseWriter, r *http.Request) {
 					_, ok := dbtrxmiddlewarepkg.FromContext(r.Context())
 					if !ok {
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 						return
 					}
 
@@ -106,7 +106,7 @@ func (ts *TestSuite) TestDBTrx() {
 
 					result := dbAux.Create(&persistentUser)
 					if result.Error != nil {
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					}
 
 					responsehttputilpkg.RespondWithJSON(w, http.StatusOK, responsehttputilpkg.Message{Text: "ok"})
@@ -138,7 +138,7 @@ func (ts *TestSuite) TestDBTrx() {
 
 					result := dbAux.Create(&persistentUser)
 					if result.Error != nil {
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					}
 
 					responsehttputilpkg.RespondWithJSON(w, http.StatusOK, responsehttputilpkg.Message{Text: "ok"})
@@ -170,7 +170,7 @@ func (ts *TestSuite) TestDBTrx() {
 
 					result := dbAux.Create(&persistentUser)
 					if result.Error != nil {
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					}
 
 					responsehttputilpkg.RespondWithJSON(w, http.StatusOK, responsehttputilpkg.Message{Text: "ok"})
@@ -204,8 +204,8 @@ func (ts *TestSuite) TestDBTrx() {
 					if result.Error != nil {
 						// It is duplicated only to test the code that evaluates
 						// if the header is already written in the WriteHeader method.
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					}
 
 					panic(customerror.New("failed"))
@@ -240,8 +240,8 @@ func (ts *TestSuite) TestDBTrx() {
 					if result.Error != nil {
 						// It is duplicated only to test the code that evaluates
 						// if the header is already written in the WriteHeader method.
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
-						responsehttputilpkg.RespondErrorWithJSON(w, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
+						responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.New("failed"))
 					}
 
 					panic("failed")
@@ -294,7 +294,11 @@ func (ts *TestSuite) TestDBTrx() {
 				Path(route.Path).
 				HandlerFunc(route.HandlerFunc)
 
-			router.ServeHTTP(resprec, req)
+			if tc.ShouldPanic {
+				assert.Panics(t, func() { router.ServeHTTP(resprec, req) }, "It should have panicked.")
+			} else {
+				router.ServeHTTP(resprec, req)
+			}
 
 			if !tc.WantError {
 				assert.Equal(t, resprec.Result().Header.Get("Content-Type"), "application/json")
@@ -304,11 +308,7 @@ func (ts *TestSuite) TestDBTrx() {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
 				assert.NotEmpty(t, returnedMessage.Text)
 			} else {
-				if tc.ShouldPanic {
-					shouldPanic(t, handlerFunc, resprec, req)
-				} else {
-					assert.Equal(t, statusCode, resprec.Result().StatusCode)
-				}
+				assert.Equal(t, statusCode, resprec.Result().StatusCode)
 			}
 
 			err := mock.ExpectationsWereMet()
@@ -316,9 +316,3 @@ func (ts *TestSuite) TestDBTrx() {
 		})
 	}
 }
-
-func shouldPanic(t *testing.T, f func(w http.ResponseWriter, r *http.Request), w http.ResponseWriter, r *http.Request) {
-	defer func() { recover() }()
-	f(w, r)
-	t.Errorf("It should have panicked.")
-}
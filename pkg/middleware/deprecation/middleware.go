@@ -0,0 +1,47 @@
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+)
+
+// Deprecation is the function that wraps a http.Handler to signal that the route is deprecated.
+// The Deprecation header carries the date of the deprecation following RFC 9745, or true if it is unknown,
+// the Sunset header carries the date of the removal following RFC 8594 and the Link header points to
+// the documentation of the deprecation and to the route that replaces it. The requests are counted by route and version.
+func Deprecation(route routehttputilpkg.Route) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		if route.Deprecation == nil {
+			return next
+		}
+
+		deprecation := *route.Deprecation
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			if deprecation.Date.IsZero() {
+				w.Header().Set("Deprecation", "true")
+			} else {
+				w.Header().Set("Deprecation", fmt.Sprintf("@%d", deprecation.Date.Unix()))
+			}
+
+			if !deprecation.Sunset.IsZero() {
+				w.Header().Set("Sunset", deprecation.Sunset.UTC().Format(http.TimeFormat))
+			}
+
+			if deprecation.Link != "" {
+				w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"deprecation\"", deprecation.Link))
+			}
+
+			if deprecation.Successor != "" {
+				w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", deprecation.Successor))
+			}
+
+			metricspkg.HTTPDeprecatedRequestsTotal.WithLabelValues(route.Name, route.Version).Inc()
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package deprecation_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	deprecationmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/deprecation"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestDeprecation() {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	route := routehttputilpkg.Route{}
+
+	headers := map[string][]string{}
+
+	count := 0.0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSignallingTheDeprecationOfARoute",
+			SetUp: func(t *testing.T) {
+				route = routehttputilpkg.Route{
+					Name:    "GetAllUsers",
+					Version: routehttputilpkg.V1,
+					Path:    "/users",
+					Deprecation: &routehttputilpkg.Deprecation{
+						Date:      time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+						Sunset:    time.Date(2026, time.December, 31, 23, 59, 59, 0, time.UTC),
+						Link:      "https://example.com/deprecations/users",
+						Successor: "/v2/users",
+					},
+				}
+				headers = map[string][]string{
+					"Deprecation": {"@1767225600"},
+					"Sunset":      {"Thu, 31 Dec 2026 23:59:59 GMT"},
+					"Link": {
+						"<https://example.com/deprecations/users>; rel=\"deprecation\"",
+						"</v2/users>; rel=\"successor-version\"",
+					},
+				}
+				count = 1
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSignallingTheDeprecationOfARouteWithoutAKnownDate",
+			SetUp: func(t *testing.T) {
+				route = routehttputilpkg.Route{
+					Name: "SignUp",
+					Path: "/sign_up",
+					Deprecation: &routehttputilpkg.Deprecation{
+						Successor: "/v1/sign_up",
+					},
+				}
+				headers = map[string][]string{
+					"Deprecation": {"true"},
+					"Link":        {"</v1/sign_up>; rel=\"successor-version\""},
+				}
+				count = 1
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSkippingARouteThatIsNotDeprecated",
+			SetUp: func(t *testing.T) {
+				route = routehttputilpkg.Route{
+					Name:    "SignIn",
+					Version: routehttputilpkg.V1,
+					Path:    "/sign_in",
+				}
+				headers = map[string][]string{}
+				count = 0
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			counter := metricspkg.HTTPDeprecatedRequestsTotal.WithLabelValues(route.Name, route.Version)
+			before := testutil.ToFloat64(counter)
+
+			req := httptest.NewRequest(http.MethodGet, route.FullPath(), nil)
+
+			resprec := httptest.NewRecorder()
+
+			deprecationmiddlewarepkg.Deprecation(route)(handler).ServeHTTP(resprec, req)
+
+			for _, name := range []string{"Deprecation", "Sunset", "Link"} {
+				assert.Equal(t, headers[name], resprec.Result().Header.Values(name))
+			}
+
+			assert.Equal(t, count, testutil.ToFloat64(counter)-before)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package locale
+
+import (
+	"net/http"
+
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+)
+
+// CookieName is the name of the cookie that carries the locale preferred by the user,
+// which takes precedence over the Accept-Language header.
+const CookieName = "locale"
+
+// Locale is the function that wraps a http.Handler to negotiate the locale of the messages of the response
+// from the preference of the user and the Accept-Language header. The locale is stored in context.
+func Locale(catalog *i18npkg.Catalog) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			preference := ""
+			if cookie, err := r.Cookie(CookieName); err == nil {
+				preference = cookie.Value
+			}
+
+			locale := catalog.Negotiate(preference, r.Header.Get("Accept-Language"))
+
+			ctx := i18npkg.NewContext(r.Context(), locale)
+			r = r.WithContext(ctx)
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package locale_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	i18npkg "github.com/icaroribeiro/go-code-challenge-template/pkg/i18n"
+	localemiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestLocale() {
+	cookie := ""
+
+	acceptLanguage := ""
+
+	locale := ""
+
+	ctxLocale := ""
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		ctxLocale = i18npkg.FromContext(r.Context())
+	}
+
+	catalog, err := i18npkg.New()
+	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInNegotiatingTheLocaleFromTheAcceptLanguageHeader",
+			SetUp: func(t *testing.T) {
+				cookie = ""
+				acceptLanguage = "fr-FR, es;q=0.9, en;q=0.8"
+				locale = i18npkg.Spanish
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInNegotiatingTheLocaleFromThePreferenceOfTheUser",
+			SetUp: func(t *testing.T) {
+				cookie = "pt-BR"
+				acceptLanguage = "es"
+				locale = i18npkg.BrazilianPortuguese
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInNegotiatingTheDefaultLocaleIfNoneOfThePreferredOnesIsSupported",
+			SetUp: func(t *testing.T) {
+				cookie = ""
+				acceptLanguage = "de-DE, fr;q=0.8"
+				locale = i18npkg.DefaultLocale
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			if cookie != "" {
+				req.AddCookie(&http.Cookie{Name: localemiddlewarepkg.CookieName, Value: cookie})
+			}
+
+			req.Header.Set("Accept-Language", acceptLanguage)
+
+			resprec := httptest.NewRecorder()
+
+			localemiddlewarepkg.Locale(catalog)(handler).ServeHTTP(resprec, req)
+
+			assert.Equal(t, locale, ctxLocale)
+		})
+	}
+}
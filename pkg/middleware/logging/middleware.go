@@ -1,17 +1,84 @@
 package logging
 
 import (
+	"log/slog"
 	"net/http"
-	"os"
+	"time"
 
-	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	clientcertmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/clientcert"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
 )
 
-// Logging is the function that wraps a http.Handler to provide the log information from the incoming request.
-func Logging() func(http.HandlerFunc) http.HandlerFunc {
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	size       int
+}
+
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	size, err := rw.ResponseWriter.Write(b)
+	rw.size += size
+	return size, err
+}
+
+// Logging is the function that wraps a http.Handler to attach a request-scoped logger to the request context
+// and to log the outcome of the incoming request.
+func Logging(logger *slog.Logger) func(http.HandlerFunc) http.HandlerFunc {
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
-			handlers.LoggingHandler(os.Stdout, next).ServeHTTP(w, r)
+			start := time.Now()
+
+			args := []any{
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+			}
+
+			if requestID, ok := requestidmiddlewarepkg.FromContext(r.Context()); ok {
+				args = append(args, slog.String("request_id", requestID))
+			}
+
+			if route := mux.CurrentRoute(r); route != nil && route.GetName() != "" {
+				args = append(args, slog.String("route", route.GetName()))
+			}
+
+			// The identity of the client certificate is set by an outer middleware, ahead of the routes.
+			if identity, ok := clientcertmiddlewarepkg.FromContext(r.Context()); ok {
+				args = append(args, slog.String("client_cert_cn", identity.CommonName))
+			}
+
+			// The auth details are usually set by an inner middleware, which adds them to the logger itself.
+			if auth, ok := authmiddlewarepkg.FromContext(r.Context()); ok && !auth.IsEmpty() {
+				args = append(args, slog.String("user_id", auth.UserID.String()), slog.String("auth_id", auth.ID.String()))
+			}
+
+			ctx := loggerpkg.NewContext(r.Context(), logger.With(args...))
+			r = r.WithContext(ctx)
+
+			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(wrapped, r)
+
+			level := slog.LevelInfo
+			switch {
+			case wrapped.statusCode >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case wrapped.statusCode >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+
+			loggerpkg.FromContext(ctx).Log(ctx, level, "request completed",
+				slog.Int("status", wrapped.statusCode),
+				slog.Int("size", wrapped.size),
+				slog.Duration("duration", time.Since(start)),
+			)
 		}
 	}
 }
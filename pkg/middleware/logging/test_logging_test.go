@@ -1,21 +1,33 @@
 package logging_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"testing"
 
-	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	clientcertmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/clientcert"
 	loggingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/logging"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
 	"github.com/stretchr/testify/assert"
 )
 
 func (ts *TestSuite) TestLogging() {
-	handler := func(w http.ResponseWriter, r *http.Request) {}
+	statusCode := 0
+
+	requestID := "request-id"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		loggerpkg.FromContext(r.Context()).Info("handled")
+		w.WriteHeader(statusCode)
+	}
 
 	route := routehttputilpkg.Route{
 		Name:        "Testing",
@@ -29,36 +41,103 @@ func (ts *TestSuite) TestLogging() {
 		Target: route.Path,
 	}
 
-	req := httptest.NewRequest(requestData.Method, requestData.Target, nil)
-	resprec := httptest.NewRecorder()
+	level := ""
 
-	router := mux.NewRouter()
-
-	router.Name(route.Name).
-		Methods(route.Method).
-		Path(route.Path).
-		HandlerFunc(route.HandlerFunc)
-
-	loggedRouter := handlers.LoggingHandler(os.Stdout, router)
-	loggedRouter.ServeHTTP(resprec, req)
+	clientCertCN := ""
 
 	ts.Cases = Cases{
 		{
-			Context: "ItShouldSucceedInWrappingAFunctionWithLoggingMiddleware",
+			Context: "ItShouldSucceedInLoggingARequestThatSucceeded",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusOK
+				level = slog.LevelInfo.String()
+				clientCertCN = ""
+			},
+		},
+		{
+			Context: "ItShouldSucceedInLoggingTheCommonNameOfTheClientCertificate",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusOK
+				level = slog.LevelInfo.String()
+				clientCertCN = "client.example.com"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInLoggingARequestThatFailedDueToTheClient",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusBadRequest
+				level = slog.LevelWarn.String()
+				clientCertCN = ""
+			},
+		},
+		{
+			Context: "ItShouldSucceedInLoggingARequestThatFailedDueToTheServer",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusInternalServerError
+				level = slog.LevelError.String()
+				clientCertCN = ""
+			},
 		},
 	}
 
 	for _, tc := range ts.Cases {
 		ts.T().Run(tc.Context, func(t *testing.T) {
-			loggingMiddleware := loggingmiddlewarepkg.Logging()
+			tc.SetUp(t)
+
+			buffer := bytes.Buffer{}
+			logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+			loggingMiddleware := loggingmiddlewarepkg.Logging(logger)
+
+			router := mux.NewRouter()
+
+			router.Name(route.Name).
+				Methods(route.Method).
+				Path(route.Path).
+				HandlerFunc(requestidmiddlewarepkg.RequestID()(loggingMiddleware(route.HandlerFunc)))
+
+			req := httptest.NewRequest(requestData.Method, requestData.Target, nil)
+			req.Header.Set("X-Request-ID", requestID)
+
+			if clientCertCN != "" {
+				identity := clientcertmiddlewarepkg.Identity{CommonName: clientCertCN}
+				req = req.WithContext(clientcertmiddlewarepkg.NewContext(req.Context(), identity))
+			}
+
+			resprec := httptest.NewRecorder()
+
+			router.ServeHTTP(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+
+			lines := bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n"))
+			assert.Len(t, lines, 2)
+
+			records := make([]map[string]interface{}, 0)
+			for _, line := range lines {
+				record := map[string]interface{}{}
+				err := json.Unmarshal(line, &record)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				records = append(records, record)
+			}
+
+			for _, record := range records {
+				assert.Equal(t, route.Method, record["method"])
+				assert.Equal(t, route.Path, record["path"])
+				assert.Equal(t, requestID, record["request_id"])
+				assert.Equal(t, route.Name, record["route"])
 
-			testReq := httptest.NewRequest(requestData.Method, requestData.Target, nil)
-			testResprec := httptest.NewRecorder()
-			logging := loggingMiddleware(handler)
-			logging.ServeHTTP(testResprec, testReq)
+				if clientCertCN != "" {
+					assert.Equal(t, clientCertCN, record["client_cert_cn"])
+				} else {
+					assert.NotContains(t, record, "client_cert_cn")
+				}
+			}
 
-			assert.Equal(t, resprec, testResprec)
-			assert.Equal(t, req, testReq)
+			assert.Equal(t, "handled", records[0]["msg"])
+			assert.Equal(t, "request completed", records[1]["msg"])
+			assert.Equal(t, level, records[1]["level"])
+			assert.Equal(t, float64(statusCode), records[1]["status"])
 		})
 	}
 }
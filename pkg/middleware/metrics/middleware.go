@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+)
+
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Metrics is the function that wraps a http.Handler to count the incoming requests and observe their latency
+// labeled by the name of the matched route.
+func Metrics() func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			routeName := "unknown"
+			if route := mux.CurrentRoute(r); route != nil && route.GetName() != "" {
+				routeName = route.GetName()
+			}
+
+			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(wrapped, r)
+
+			metricspkg.HTTPRequestsTotal.WithLabelValues(routeName, r.Method, strconv.Itoa(wrapped.statusCode)).Inc()
+			metricspkg.HTTPRequestDuration.WithLabelValues(routeName, r.Method).Observe(time.Since(start).Seconds())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gorilla/mux"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	metricsmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestMetrics() {
+	statusCode := 0
+
+	routeName := "Testing"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCountingARequestThatSucceeded",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusOK
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInCountingARequestThatFailed",
+			SetUp: func(t *testing.T) {
+				statusCode = http.StatusNotFound
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			counter := metricspkg.HTTPRequestsTotal.WithLabelValues(routeName, http.MethodGet, strconv.Itoa(statusCode))
+			count := testutil.ToFloat64(counter)
+
+			router := mux.NewRouter()
+
+			router.Name(routeName).
+				Methods(http.MethodGet).
+				Path("/testing").
+				HandlerFunc(metricsmiddlewarepkg.Metrics()(handler))
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			resprec := httptest.NewRecorder()
+
+			router.ServeHTTP(resprec, req)
+
+			if !tc.WantError {
+				assert.Equal(t, statusCode, resprec.Result().StatusCode)
+				assert.Equal(t, count+1, testutil.ToFloat64(counter))
+			}
+		})
+	}
+}
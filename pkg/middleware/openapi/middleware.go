@@ -0,0 +1,187 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+)
+
+const (
+	// CodeValidationFailed is the code of the requests whose bodies do not match the specification.
+	CodeValidationFailed = "VALIDATION_FAILED"
+	// CodeResponseValidationFailed is the code of the responses whose bodies do not match the specification.
+	CodeResponseValidationFailed = "RESPONSE_VALIDATION_FAILED"
+)
+
+// Config is the configuration of the validation against the specification.
+type Config struct {
+	// Requests enables the validation of the bodies of the requests, which are rejected with bad request if they do not match.
+	Requests bool
+	// Responses enables the validation of the bodies of the responses, which are replaced by an internal server error
+	// if they do not match. It is meant for the tests, since the responses are buffered.
+	Responses bool
+}
+
+// Validate is the function that wraps a http.Handler to validate the bodies of the requests and the responses of the route
+// against its operation in the OpenAPI document. The route is not validated if the document has no operation for it.
+func Validate(document *openapipkg.Document, route routehttputilpkg.Route, config Config) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		operation, ok := document.Operation(route.Method, route.FullPath())
+		if !ok || (!config.Requests && !config.Responses) {
+			return next
+		}
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			if config.Requests && operation.RequestBody != nil {
+				if err := validateRequest(document, operation, r); err != nil {
+					responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+					return
+				}
+			}
+
+			if !config.Responses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(recorder, r)
+
+			if err := validateResponse(document, operation, recorder); err != nil {
+				loggerpkg.FromContext(r.Context()).Error("the response does not match the specification",
+					slog.String("route", route.Name), slog.Any("error", err))
+
+				// The headers set for the discarded response are not sent along with the error.
+				for name := range w.Header() {
+					if name != "X-Request-Id" {
+						w.Header().Del(name)
+					}
+				}
+
+				responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+				return
+			}
+
+			w.WriteHeader(recorder.statusCode)
+			w.Write(recorder.body.Bytes())
+		}
+	}
+}
+
+// validateRequest is the function that validates the JSON body of a request, which is restored for the handler.
+func validateRequest(document *openapipkg.Document, operation *openapipkg.Operation, r *http.Request) error {
+	mediaType, ok := operation.RequestBody.Content[responsehttputilpkg.ContentTypeJSON]
+	if !ok {
+		return nil
+	}
+
+	body := json.RawMessage{}
+
+	if err := requesthttputilpkg.DecodeJSON(r, &body); err != nil {
+		return err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	fieldErrors, err := document.Validate(mediaType.Schema, body)
+	if err != nil {
+		return customerror.BadRequest.New(err.Error())
+	}
+
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	err = customerror.WithCode(customerror.BadRequest.New(describe(fieldErrors)), CodeValidationFailed)
+
+	return customerror.WithFieldErrors(err, fieldErrors...)
+}
+
+// validateResponse is the function that validates the JSON body of a response recorded from the handler.
+// The responses whose media types or status codes are not documented are not validated.
+func validateResponse(document *openapipkg.Document, operation *openapipkg.Operation, recorder *responseRecorder) error {
+	response, ok := operation.Responses[strconv.Itoa(recorder.statusCode)]
+	if !ok {
+		response, ok = operation.Responses["default"]
+		if !ok {
+			return nil
+		}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(recorder.Header().Get("Content-Type"))
+	if err != nil {
+		return nil
+	}
+
+	content, ok := response.Content[mediaType]
+	if !ok {
+		return nil
+	}
+
+	fieldErrors, err := document.Validate(content.Schema, recorder.body.Bytes())
+	if err != nil {
+		return customerror.WithCode(customerror.Newf("the response body is invalid: %w", err), CodeResponseValidationFailed)
+	}
+
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	err = customerror.WithCode(customerror.Newf("the response body does not match the specification: %s", describe(fieldErrors)),
+		CodeResponseValidationFailed)
+
+	return customerror.WithFieldErrors(err, fieldErrors...)
+}
+
+// describe is the function that lists the errors of the fields along with their JSON paths.
+func describe(fieldErrors []customerror.FieldError) string {
+	messages := make([]string, 0, len(fieldErrors))
+
+	for _, fieldError := range fieldErrors {
+		if fieldError.Field == "" {
+			messages = append(messages, fieldError.Message)
+			continue
+		}
+
+		messages = append(messages, fieldError.Field+": "+fieldError.Message)
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+// responseRecorder is the component that buffers the response written by the handler, so that it can be validated before being sent.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// WriteHeader is the function that records the status code of the response.
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.wroteHeader {
+		return
+	}
+
+	r.statusCode = statusCode
+	r.wroteHeader = true
+}
+
+// Write is the function that records the body of the response.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(b)
+}
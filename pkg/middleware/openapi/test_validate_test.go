@@ -0,0 +1,119 @@
+package openapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+	openapimiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/openapi"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestValidate() {
+	config := openapimiddlewarepkg.Config{}
+
+	body := ""
+
+	response := interface{}(nil)
+
+	handlerCalled := false
+
+	statusCode := 0
+
+	code := ""
+
+	route := routehttputilpkg.Route{
+		Name:    "SignIn",
+		Method:  http.MethodPost,
+		Version: routehttputilpkg.V1,
+		Path:    "/sign_in",
+		Doc: &routehttputilpkg.Doc{
+			Request:   securitypkg.Credentials{},
+			Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+		},
+	}
+
+	document := openapipkg.Generate(openapipkg.Info{}, routehttputilpkg.Routes{route})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		responsehttputilpkg.RespondWithJSON(w, http.StatusOK, response)
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInPassingTheRequestAndTheResponseThatMatchTheSpecification",
+			SetUp: func(t *testing.T) {
+				config = openapimiddlewarepkg.Config{Requests: true, Responses: true}
+				body = `{"username": "username", "password": "password"}`
+				response = tokenhttputilpkg.Token{Text: "token"}
+				statusCode = http.StatusOK
+				code = ""
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheRequestDoesNotMatchTheSpecification",
+			SetUp: func(t *testing.T) {
+				config = openapimiddlewarepkg.Config{Requests: true}
+				body = `{"username": "username"}`
+				response = tokenhttputilpkg.Token{Text: "token"}
+				statusCode = http.StatusBadRequest
+				code = openapimiddlewarepkg.CodeValidationFailed
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheResponseDoesNotMatchTheSpecification",
+			SetUp: func(t *testing.T) {
+				config = openapimiddlewarepkg.Config{Responses: true}
+				body = `{"username": "username", "password": "password"}`
+				response = responsehttputilpkg.Message{Text: "message"}
+				statusCode = http.StatusInternalServerError
+				code = ""
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldSucceedInPassingTheRequestThatDoesNotMatchTheSpecificationIfTheValidationIsDisabled",
+			SetUp: func(t *testing.T) {
+				config = openapimiddlewarepkg.Config{}
+				body = `{"username": "username"}`
+				response = responsehttputilpkg.Message{Text: "message"}
+				statusCode = http.StatusOK
+				code = ""
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			handlerCalled = false
+
+			req := httptest.NewRequest(route.Method, route.FullPath(), strings.NewReader(body))
+			req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+
+			resprec := httptest.NewRecorder()
+
+			openapimiddlewarepkg.Validate(document, route, config)(handler).ServeHTTP(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+
+			if !tc.WantError {
+				assert.True(t, handlerCalled)
+			} else {
+				assert.Contains(t, resprec.Header().Get("Content-Type"), "json")
+				assert.Contains(t, resprec.Body.String(), code)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package ratelimit
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+)
+
+const (
+	// KeyByIP identifies the client by its IP address.
+	KeyByIP = "ip"
+	// KeyByUser identifies the client by the ID of the authenticated user, falling back to its IP address.
+	KeyByUser = "user"
+)
+
+// Rule is the policy applied to a route along with the way its clients are identified.
+type Rule struct {
+	Policy ratelimitpkg.Policy
+	KeyBy  string
+}
+
+// ParseTrustedProxies is the function that parses a comma-separated list of IP addresses and CIDR ranges
+// of the proxies whose X-Forwarded-For header can be trusted.
+func ParseTrustedProxies(value string) ([]*net.IPNet, error) {
+	trustedProxies := make([]*net.IPNet, 0)
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("the trusted proxy %q is not a valid IP address", item)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+
+			trustedProxies = append(trustedProxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("the trusted proxy %q is not a valid CIDR range", item)
+		}
+
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+
+	return trustedProxies, nil
+}
+
+func isTrusted(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP is the function that returns the IP address of the client that performed the request.
+// The X-Forwarded-For header is only taken into account when the request comes from a trusted proxy,
+// in which case it is read from right to left up to the first address that is not a trusted proxy.
+func ClientIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	remoteAddr := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+
+	ip := net.ParseIP(remoteAddr)
+	if ip == nil || !isTrusted(ip, trustedProxies) {
+		return remoteAddr
+	}
+
+	forwardedFor := make([]string, 0)
+	for _, value := range r.Header.Values("X-Forwarded-For") {
+		forwardedFor = append(forwardedFor, strings.Split(value, ",")...)
+	}
+
+	clientIP := ip.String()
+
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		forwardedIP := net.ParseIP(strings.TrimSpace(forwardedFor[i]))
+		if forwardedIP == nil {
+			break
+		}
+
+		clientIP = forwardedIP.String()
+
+		if !isTrusted(forwardedIP, trustedProxies) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+// seconds is the function that rounds a duration up to whole seconds.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// RateLimit is the function that wraps a http.Handler to throttle the requests of the routes that have a rule,
+// keyed by the route name and the client. The rate limit headers are set in the response and the requests that
+// exceed the limit are rejected with too many requests. The requests are let through when the store fails.
+func RateLimit(store ratelimitpkg.Store, rules map[string]Rule, trustedProxies []*net.IPNet) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			route := mux.CurrentRoute(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rule, ok := rules[route.GetName()]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			throttle(w, r, next, store, route.GetName(), rule, trustedProxies)
+		}
+	}
+}
+
+// Throttle is the function that wraps a http.Handler to throttle its requests with a rule, keyed by
+// the route name and the client, the same way as RateLimit does for the routes that have a rule.
+func Throttle(store ratelimitpkg.Store, rule Rule, trustedProxies []*net.IPNet) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			route := mux.CurrentRoute(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			throttle(w, r, next, store, route.GetName(), rule, trustedProxies)
+		}
+	}
+}
+
+// throttle is the function that takes a token from the bucket of the client of the route,
+// rejecting the request if there is none left.
+func throttle(w http.ResponseWriter, r *http.Request, next http.HandlerFunc, store ratelimitpkg.Store,
+	routeName string, rule Rule, trustedProxies []*net.IPNet) {
+	key := fmt.Sprintf("%s:ip:%s", routeName, ClientIP(r, trustedProxies))
+
+	if rule.KeyBy == KeyByUser {
+		if auth, ok := authmiddlewarepkg.FromContext(r.Context()); ok {
+			key = fmt.Sprintf("%s:user:%s", routeName, auth.UserID.String())
+		}
+	}
+
+	result, err := store.Take(r.Context(), key, rule.Policy)
+	if err != nil {
+		loggerpkg.FromContext(r.Context()).Warn("failed to apply the rate limit", slog.Any("error", err))
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(seconds(result.ResetAfter)))
+	w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", rule.Policy.Limit, seconds(rule.Policy.Period)))
+
+	if !result.Allowed {
+		metricspkg.HTTPRateLimitedTotal.WithLabelValues(routeName).Inc()
+
+		w.Header().Set("Retry-After", strconv.Itoa(seconds(result.RetryAfter)))
+
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.TooManyRequests.New("too many requests, try again later"))
+		return
+	}
+
+	next.ServeHTTP(w, r)
+}
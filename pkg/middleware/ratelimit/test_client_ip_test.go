@@ -0,0 +1,69 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestClientIP() {
+	trustedProxies, _ := ratelimitmiddlewarepkg.ParseTrustedProxies("10.0.0.0/8")
+
+	remoteAddr := ""
+
+	forwardedFor := ""
+
+	clientIP := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInReturningTheRemoteAddressIfItIsNotATrustedProxy",
+			SetUp: func(t *testing.T) {
+				remoteAddr = "192.0.2.1:1234"
+				forwardedFor = "198.51.100.7"
+				clientIP = "192.0.2.1"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInReturningTheForwardedAddressIfTheRemoteAddressIsATrustedProxy",
+			SetUp: func(t *testing.T) {
+				remoteAddr = "10.0.0.1:1234"
+				forwardedFor = "198.51.100.7"
+				clientIP = "198.51.100.7"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSkippingTheTrustedProxiesAndIgnoringTheSpoofedAddresses",
+			SetUp: func(t *testing.T) {
+				remoteAddr = "10.0.0.1:1234"
+				forwardedFor = "203.0.113.9, 198.51.100.7, 10.0.0.2"
+				clientIP = "198.51.100.7"
+			},
+		},
+		{
+			Context: "ItShouldSucceedInReturningTheRemoteAddressIfTheForwardedAddressIsNotValid",
+			SetUp: func(t *testing.T) {
+				remoteAddr = "10.0.0.1:1234"
+				forwardedFor = "unknown"
+				clientIP = "10.0.0.1"
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/sign_in", nil)
+			req.RemoteAddr = remoteAddr
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+
+			returnedClientIP := ratelimitmiddlewarepkg.ClientIP(req, trustedProxies)
+
+			assert.Equal(t, clientIP, returnedClientIP)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit_test
+
+import (
+	"fmt"
+	"testing"
+
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestParseTrustedProxies() {
+	value := ""
+
+	trustedProxies := make([]string, 0)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInParsingAnEmptyList",
+			SetUp: func(t *testing.T) {
+				value = ""
+				trustedProxies = []string{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingIPAddressesAndCIDRRanges",
+			SetUp: func(t *testing.T) {
+				value = "10.0.0.0/8, 192.0.2.1,::1"
+				trustedProxies = []string{"10.0.0.0/8", "192.0.2.1/32", "::1/128"}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfAnIPAddressIsNotValid",
+			SetUp: func(t *testing.T) {
+				value = "10.0.0.256"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfACIDRRangeIsNotValid",
+			SetUp: func(t *testing.T) {
+				value = "10.0.0.0/33"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedTrustedProxies, err := ratelimitmiddlewarepkg.ParseTrustedProxies(value)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Len(t, returnedTrustedProxies, len(trustedProxies))
+				for i := range trustedProxies {
+					assert.Equal(t, trustedProxies[i], returnedTrustedProxies[i].String())
+				}
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
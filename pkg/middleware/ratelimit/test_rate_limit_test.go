@@ -0,0 +1,207 @@
+package ratelimit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	domainentity "github.com/icaroribeiro/go-code-challenge-template/internal/core/domain/entity"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	authmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/auth"
+	ratelimitmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/ratelimit"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(ctx context.Context, key string, policy ratelimitpkg.Policy) (ratelimitpkg.Result, error) {
+	return ratelimitpkg.Result{}, errors.New("failed")
+}
+
+func (failingStore) Close() error {
+	return nil
+}
+
+func (ts *TestSuite) TestRateLimit() {
+	routeName := "Testing"
+
+	policy := ratelimitpkg.Policy{Limit: 2, Period: time.Minute, Burst: 2}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	var store ratelimitpkg.Store
+
+	rules := map[string]ratelimitmiddlewarepkg.Rule{}
+
+	requests := make([]*http.Request, 0)
+
+	newRequest := func(remoteAddr string, userID uuid.UUID) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/testing", nil)
+		req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+		req.RemoteAddr = remoteAddr
+		if userID != uuid.Nil {
+			req = req.WithContext(authmiddlewarepkg.NewContext(req.Context(), domainentity.Auth{UserID: userID}))
+		}
+		return req
+	}
+
+	statusCode := 0
+
+	rateLimitHeaders := false
+
+	rejections := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingTheRequestsOfARouteWithoutRulePassThrough",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]ratelimitmiddlewarepkg.Rule{}
+				requests = []*http.Request{
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+				}
+				statusCode = http.StatusOK
+				rateLimitHeaders = false
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInAllowingTheRequestsWithinTheLimit",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]ratelimitmiddlewarepkg.Rule{
+					routeName: {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				requests = []*http.Request{
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.2:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+				}
+				statusCode = http.StatusOK
+				rateLimitHeaders = true
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInLettingTheRequestsPassThroughIfTheStoreFails",
+			SetUp: func(t *testing.T) {
+				store = failingStore{}
+				rules = map[string]ratelimitmiddlewarepkg.Rule{
+					routeName: {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				requests = []*http.Request{
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+				}
+				statusCode = http.StatusOK
+				rateLimitHeaders = false
+				rejections = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheRequestsOfAnIPAddressExceedTheLimit",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]ratelimitmiddlewarepkg.Rule{
+					routeName: {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByIP},
+				}
+				requests = []*http.Request{
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+					newRequest("192.0.2.1:1234", uuid.Nil),
+				}
+				statusCode = http.StatusTooManyRequests
+				rateLimitHeaders = true
+				rejections = 1
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheRequestsOfAUserExceedTheLimitFromDifferentIPAddresses",
+			SetUp: func(t *testing.T) {
+				store = ratelimitpkg.NewMemoryStore()
+				rules = map[string]ratelimitmiddlewarepkg.Rule{
+					routeName: {Policy: policy, KeyBy: ratelimitmiddlewarepkg.KeyByUser},
+				}
+				userID := uuid.NewV4()
+				requests = []*http.Request{
+					newRequest("192.0.2.1:1234", userID),
+					newRequest("192.0.2.2:1234", userID),
+					newRequest("192.0.2.3:1234", userID),
+				}
+				statusCode = http.StatusTooManyRequests
+				rateLimitHeaders = true
+				rejections = 1
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			counter := metricspkg.HTTPRateLimitedTotal.WithLabelValues(routeName)
+			count := testutil.ToFloat64(counter)
+
+			rateLimitMiddleware := ratelimitmiddlewarepkg.RateLimit(store, rules, nil)
+
+			router := mux.NewRouter()
+
+			router.Name(routeName).
+				Methods(http.MethodPost).
+				Path("/testing").
+				HandlerFunc(rateLimitMiddleware(handler))
+
+			resprec := httptest.NewRecorder()
+
+			for _, req := range requests {
+				resprec = httptest.NewRecorder()
+				router.ServeHTTP(resprec, req)
+			}
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+			assert.Equal(t, count+float64(rejections), testutil.ToFloat64(counter))
+
+			if rateLimitHeaders {
+				assert.Equal(t, "2", resprec.Header().Get("RateLimit-Limit"))
+				assert.NotEmpty(t, resprec.Header().Get("RateLimit-Remaining"))
+				assert.NotEmpty(t, resprec.Header().Get("RateLimit-Reset"))
+				assert.Equal(t, "2;w=60", resprec.Header().Get("RateLimit-Policy"))
+			} else {
+				assert.Empty(t, resprec.Header().Get("RateLimit-Limit"))
+			}
+
+			if !tc.WantError {
+				assert.Empty(t, resprec.Header().Get("Retry-After"))
+			} else {
+				assert.Equal(t, "0", resprec.Header().Get("RateLimit-Remaining"))
+				assert.Equal(t, "30", resprec.Header().Get("Retry-After"))
+
+				problem := responsehttputilpkg.Problem{}
+				err := json.NewDecoder(resprec.Body).Decode(&problem)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.NotEmpty(t, problem.Detail)
+				assert.Equal(t, "TOO_MANY_REQUESTS", problem.Code)
+			}
+		})
+	}
+}
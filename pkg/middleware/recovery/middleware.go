@@ -0,0 +1,82 @@
+package recovery
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gorilla/mux"
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+)
+
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.wroteHeader = true
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	return rw.ResponseWriter.Write(b)
+}
+
+// Recovery is the function that wraps a http.Handler to recover from a panic raised by the next handlers,
+// so that it is logged along with its stack trace and the client receives an internal server error.
+// The response is left as it is when the next handlers have already started writing it.
+func Recovery() func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			wrapped := &responseWriter{ResponseWriter: w}
+
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+
+				// The panic used by net/http to abort a response is propagated, since it is deliberate.
+				if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(rec)
+				}
+
+				routeName := "unknown"
+				if route := mux.CurrentRoute(r); route != nil && route.GetName() != "" {
+					routeName = route.GetName()
+				}
+
+				metricspkg.HTTPPanicsTotal.WithLabelValues(routeName).Inc()
+
+				args := []any{
+					slog.Any("panic", rec),
+					slog.String("stack", string(debug.Stack())),
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.String("route", routeName),
+				}
+
+				if requestID, ok := requestidmiddlewarepkg.FromContext(r.Context()); ok {
+					args = append(args, slog.String("request_id", requestID))
+				}
+
+				loggerpkg.FromContext(r.Context()).Error("panic recovered", args...)
+
+				if wrapped.wroteHeader {
+					return
+				}
+
+				responsehttputilpkg.RespondErrorWithJSON(wrapped, r, customerror.New("an unexpected error occurred"))
+			}()
+
+			next.ServeHTTP(wrapped, r)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package recovery_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
+	recoverymiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/recovery"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestRecovery() {
+	routeName := "Testing"
+
+	requestID := "request-id"
+
+	var handler http.HandlerFunc
+
+	statusCode := 0
+
+	body := ""
+
+	panicsCount := 0
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInLettingARequestWithoutPanicPassThrough",
+			SetUp: func(t *testing.T) {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}
+				statusCode = http.StatusOK
+				panicsCount = 0
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRespondingWithInternalServerErrorIfThePanicHappensBeforeTheResponseIsWritten",
+			SetUp: func(t *testing.T) {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					panic("failed")
+				}
+				statusCode = http.StatusInternalServerError
+				panicsCount = 1
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldSucceedInKeepingTheResponseIfThePanicHappensAfterItIsWritten",
+			SetUp: func(t *testing.T) {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusAccepted)
+					w.Write([]byte("partial"))
+					panic("failed")
+				}
+				statusCode = http.StatusAccepted
+				body = "partial"
+				panicsCount = 1
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			body = ""
+
+			tc.SetUp(t)
+
+			counter := metricspkg.HTTPPanicsTotal.WithLabelValues(routeName)
+			count := testutil.ToFloat64(counter)
+
+			router := mux.NewRouter()
+
+			router.Name(routeName).
+				Methods(http.MethodGet).
+				Path("/testing").
+				HandlerFunc(recoverymiddlewarepkg.Recovery()(handler))
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			req.Header.Set("Accept", responsehttputilpkg.ContentTypeProblemJSON)
+			req.Header.Set(requestidmiddlewarepkg.HeaderName, requestID)
+
+			resprec := httptest.NewRecorder()
+
+			requestidmiddlewarepkg.RequestID()(router.ServeHTTP).ServeHTTP(resprec, req)
+
+			assert.Equal(t, statusCode, resprec.Result().StatusCode)
+			assert.Equal(t, count+float64(panicsCount), testutil.ToFloat64(counter))
+
+			if !tc.WantError {
+				assert.Equal(t, body, resprec.Body.String())
+			} else {
+				problem := responsehttputilpkg.Problem{}
+				err := json.NewDecoder(resprec.Body).Decode(&problem)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.NotEmpty(t, problem.Detail)
+				assert.Equal(t, requestID, problem.RequestID)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// HeaderName is the name of the header that carries the request ID.
+const HeaderName = "X-Request-ID"
+
+// maxLength is the maximum length of an incoming request ID.
+const maxLength = 128
+
+var requestIDCtxKey = &contextKey{"request_id"}
+
+type contextKey struct {
+	name string
+}
+
+// NewContext is the function that returns a new Context that carries request_id value.
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey, requestID)
+}
+
+// FromContext is the function that returns the request_id value stored in context, if any.
+func FromContext(ctx context.Context) (string, bool) {
+	raw, ok := ctx.Value(requestIDCtxKey).(string)
+	return raw, ok
+}
+
+// IsValid is the function that checks if an incoming request ID can be trusted, that is, if it is not empty,
+// not too long and only made of letters, digits and the characters '-', '_', '.' and ':'.
+func IsValid(requestID string) bool {
+	if requestID == "" || len(requestID) > maxLength {
+		return false
+	}
+
+	for _, c := range requestID {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// RequestID is the function that wraps a http.Handler to identify the request by the request ID informed
+// by the client, when valid, or by a generated one. The request ID is stored in context and echoed in the response.
+func RequestID() func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(HeaderName)
+
+			if !IsValid(requestID) {
+				requestID = uuid.NewV4().String()
+			}
+
+			// The request ID is set in the response header before proceeding, so that it can be
+			// included in the responses written by the next handlers.
+			w.Header().Set(HeaderName, requestID)
+
+			r.Header.Set(HeaderName, requestID)
+
+			ctx := NewContext(r.Context(), requestID)
+			r = r.WithContext(ctx)
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
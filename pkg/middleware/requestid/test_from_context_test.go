@@ -0,0 +1,39 @@
+package requestid_test
+
+import (
+	"context"
+	"testing"
+
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestFromContext() {
+	requestIDCtxValue := ""
+
+	ctx := context.Background()
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGettingAnAssociatedValueFromAContext",
+			SetUp: func(t *testing.T) {
+				requestIDCtxValue = "request-id"
+				ctx = requestidmiddlewarepkg.NewContext(ctx, requestIDCtxValue)
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedRequestIDCtxValue, ok := requestidmiddlewarepkg.FromContext(ctx)
+
+			if !tc.WantError {
+				assert.True(t, ok, "Unexpected type assertion error.")
+				assert.Equal(t, requestIDCtxValue, returnedRequestIDCtxValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package requestid_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	requestidmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/requestid"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestRequestID() {
+	requestID := ""
+
+	isGenerated := false
+
+	ctxRequestID := ""
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		ctxRequestID, _ = requestidmiddlewarepkg.FromContext(r.Context())
+		responsehttputilpkg.RespondErrorWithJSON(w, r, customerror.BadRequest.New("failed"))
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInKeepingAValidRequestIDInformedByTheClient",
+			SetUp: func(t *testing.T) {
+				requestID = "5f0c7a1e-support:ticket_42.retry"
+				isGenerated = false
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingARequestIDIfItIsNotInformed",
+			SetUp: func(t *testing.T) {
+				requestID = ""
+				isGenerated = true
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingARequestIDIfTheInformedOneHasInvalidCharacters",
+			SetUp: func(t *testing.T) {
+				requestID = "request id\r\nSet-Cookie: x"
+				isGenerated = true
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingARequestIDIfTheInformedOneIsTooLong",
+			SetUp: func(t *testing.T) {
+				requestID = strings.Repeat("a", 129)
+				isGenerated = true
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			if requestID != "" {
+				req.Header.Set(requestidmiddlewarepkg.HeaderName, requestID)
+			}
+
+			resprec := httptest.NewRecorder()
+
+			requestidmiddlewarepkg.RequestID()(handler).ServeHTTP(resprec, req)
+
+			returnedRequestID := resprec.Result().Header.Get(requestidmiddlewarepkg.HeaderName)
+
+			if !isGenerated {
+				assert.Equal(t, requestID, returnedRequestID)
+			} else {
+				_, err := uuid.FromString(returnedRequestID)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			}
+
+			assert.Equal(t, returnedRequestID, ctxRequestID)
+
+			problem := responsehttputilpkg.Problem{}
+			err := json.NewDecoder(resprec.Body).Decode(&problem)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, returnedRequestID, problem.RequestID)
+		})
+	}
+}
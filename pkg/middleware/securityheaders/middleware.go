@@ -0,0 +1,69 @@
+package securityheaders
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// APIContentSecurityPolicy is the policy of the JSON responses, which are not supposed to load anything.
+	APIContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+	// SwaggerUIContentSecurityPolicy is the policy of the swagger UI, whose page relies on inline scripts and styles.
+	SwaggerUIContentSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; " +
+		"img-src 'self' data:; frame-ancestors 'none'"
+)
+
+// Config is the set of settings of the security headers.
+type Config struct {
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	FrameOptions          string
+	ContentSecurityPolicy string
+	// RouteContentSecurityPolicies overrides the content security policy by route name.
+	RouteContentSecurityPolicies map[string]string
+}
+
+// SecurityHeaders is the function that wraps a http.Handler to set the headers that instruct browsers
+// to harden the handling of the responses.
+func SecurityHeaders(config Config) func(http.HandlerFunc) http.HandlerFunc {
+	hsts := ""
+	if config.HSTSMaxAge > 0 {
+		hsts = fmt.Sprintf("max-age=%d", int(config.HSTSMaxAge.Seconds()))
+		if config.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+
+			h.Set("X-Content-Type-Options", "nosniff")
+			h.Set("Referrer-Policy", "no-referrer")
+
+			if hsts != "" {
+				h.Set("Strict-Transport-Security", hsts)
+			}
+
+			if config.FrameOptions != "" {
+				h.Set("X-Frame-Options", config.FrameOptions)
+			}
+
+			contentSecurityPolicy := config.ContentSecurityPolicy
+			if route := mux.CurrentRoute(r); route != nil {
+				if value, ok := config.RouteContentSecurityPolicies[route.GetName()]; ok {
+					contentSecurityPolicy = value
+				}
+			}
+
+			if contentSecurityPolicy != "" {
+				h.Set("Content-Security-Policy", contentSecurityPolicy)
+			}
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
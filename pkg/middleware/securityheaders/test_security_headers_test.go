@@ -0,0 +1,91 @@
+package securityheaders_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	securityheadersmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/securityheaders"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestSecurityHeaders() {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	config := securityheadersmiddlewarepkg.Config{}
+
+	target := ""
+
+	headers := map[string]string{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSettingTheSecurityHeadersOfAnAPIRoute",
+			SetUp: func(t *testing.T) {
+				config = securityheadersmiddlewarepkg.Config{
+					HSTSMaxAge:            365 * 24 * time.Hour,
+					HSTSIncludeSubdomains: true,
+					FrameOptions:          "DENY",
+					ContentSecurityPolicy: securityheadersmiddlewarepkg.APIContentSecurityPolicy,
+					RouteContentSecurityPolicies: map[string]string{
+						"Swagger": securityheadersmiddlewarepkg.SwaggerUIContentSecurityPolicy,
+					},
+				}
+				target = "/status"
+				headers = map[string]string{
+					"X-Content-Type-Options":    "nosniff",
+					"Referrer-Policy":           "no-referrer",
+					"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+					"X-Frame-Options":           "DENY",
+					"Content-Security-Policy":   securityheadersmiddlewarepkg.APIContentSecurityPolicy,
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInSettingTheContentSecurityPolicyOfTheRoute",
+			SetUp: func(t *testing.T) {
+				config = securityheadersmiddlewarepkg.Config{
+					ContentSecurityPolicy: securityheadersmiddlewarepkg.APIContentSecurityPolicy,
+					RouteContentSecurityPolicies: map[string]string{
+						"Swagger": securityheadersmiddlewarepkg.SwaggerUIContentSecurityPolicy,
+					},
+				}
+				target = "/swagger/index.html"
+				headers = map[string]string{
+					"X-Content-Type-Options":    "nosniff",
+					"Strict-Transport-Security": "",
+					"X-Frame-Options":           "",
+					"Content-Security-Policy":   securityheadersmiddlewarepkg.SwaggerUIContentSecurityPolicy,
+				}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			securityHeadersMiddleware := securityheadersmiddlewarepkg.SecurityHeaders(config)
+
+			router := mux.NewRouter()
+			router.Name("GetStatus").Methods(http.MethodGet).Path("/status").HandlerFunc(securityHeadersMiddleware(handler))
+			router.Name("Swagger").Methods(http.MethodGet).PathPrefix("/swagger").HandlerFunc(securityHeadersMiddleware(handler))
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+
+			resprec := httptest.NewRecorder()
+
+			router.ServeHTTP(resprec, req)
+
+			assert.Equal(t, http.StatusOK, resprec.Result().StatusCode)
+
+			for key, value := range headers {
+				assert.Equal(t, value, resprec.Header().Get(key), key)
+			}
+		})
+	}
+}
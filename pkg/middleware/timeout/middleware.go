@@ -0,0 +1,25 @@
+package timeout
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Timeout is the function that wraps a http.Handler to give it up to the timeout to respond.
+// The context of the request is cancelled once the timeout expires, so that the queries and the calls
+// made on behalf of it are aborted.
+func Timeout(timeout time.Duration) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package timeout_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	timeoutmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/timeout"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestTimeout() {
+	timeout := time.Duration(0)
+
+	hasDeadline := false
+
+	deadline := time.Time{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSettingTheDeadlineOfTheRequest",
+			SetUp: func(t *testing.T) {
+				timeout = 5 * time.Second
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInKeepingTheRequestWithoutDeadlineIfThereIsNoTimeout",
+			SetUp: func(t *testing.T) {
+				timeout = 0
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+
+			resprec := httptest.NewRecorder()
+
+			start := time.Now()
+
+			timeoutmiddlewarepkg.Timeout(timeout)(handler).ServeHTTP(resprec, req)
+
+			if timeout > 0 {
+				assert.True(t, hasDeadline)
+				assert.WithinDuration(t, start.Add(timeout), deadline, time.Second)
+			} else {
+				assert.False(t, hasDeadline)
+			}
+		})
+	}
+}
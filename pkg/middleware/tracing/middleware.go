@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	loggerpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/logger"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Tracing is the function that wraps a http.Handler to continue the trace informed by the W3C traceparent header,
+// if any, and to record a span named after the matched route around the next handlers.
+func Tracing() func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			spanName := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+			routeName := ""
+
+			if route := mux.CurrentRoute(r); route != nil && route.GetName() != "" {
+				routeName = route.GetName()
+				spanName = routeName
+			}
+
+			ctx, span := tracingpkg.Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path),
+					attribute.String("http.route", routeName),
+				),
+			)
+			defer span.End()
+
+			if spanContext := span.SpanContext(); spanContext.IsValid() {
+				loggerpkg.With(ctx, slog.String("trace_id", spanContext.TraceID().String()), slog.String("span_id", spanContext.SpanID().String()))
+			}
+
+			r = r.WithContext(ctx)
+
+			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(wrapped, r)
+
+			span.SetAttributes(attribute.Int("http.response.status_code", wrapped.statusCode))
+
+			if wrapped.statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(wrapped.statusCode))
+			}
+		}
+	}
+}
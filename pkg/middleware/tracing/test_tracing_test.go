@@ -0,0 +1,102 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	tracingmiddlewarepkg "github.com/icaroribeiro/go-code-challenge-template/pkg/middleware/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func (ts *TestSuite) TestTracing() {
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	parentSpanID := "00f067aa0ba902b7"
+
+	traceparent := ""
+
+	statusCode := 0
+
+	spanStatusCode := codes.Unset
+
+	routeName := "Testing"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInContinuingTheTraceInformedByTheClient",
+			SetUp: func(t *testing.T) {
+				traceparent = "00-" + traceID + "-" + parentSpanID + "-01"
+				statusCode = http.StatusOK
+				spanStatusCode = codes.Unset
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInStartingANewTraceIfNoneIsInformed",
+			SetUp: func(t *testing.T) {
+				traceparent = ""
+				statusCode = http.StatusOK
+				spanStatusCode = codes.Unset
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInMarkingTheSpanAsFailedIfTheServerFails",
+			SetUp: func(t *testing.T) {
+				traceparent = ""
+				statusCode = http.StatusInternalServerError
+				spanStatusCode = codes.Error
+			},
+			WantError: false,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			spanRecorder := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+
+			router := mux.NewRouter()
+
+			router.Name(routeName).
+				Methods(http.MethodGet).
+				Path("/testing").
+				HandlerFunc(tracingmiddlewarepkg.Tracing()(handler))
+
+			req := httptest.NewRequest(http.MethodGet, "/testing", nil)
+			if traceparent != "" {
+				req.Header.Set("traceparent", traceparent)
+			}
+
+			resprec := httptest.NewRecorder()
+
+			router.ServeHTTP(resprec, req)
+
+			spans := spanRecorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, routeName, spans[0].Name())
+			assert.Equal(t, spanStatusCode, spans[0].Status().Code)
+
+			if traceparent != "" {
+				assert.Equal(t, traceID, spans[0].SpanContext().TraceID().String())
+				assert.Equal(t, parentSpanID, spans[0].Parent().SpanID().String())
+			} else {
+				assert.False(t, spans[0].Parent().IsValid())
+			}
+		})
+	}
+}
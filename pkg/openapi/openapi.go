@@ -0,0 +1,215 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+)
+
+// Version is the version of the OpenAPI specification the documents follow.
+const Version = "3.1.0"
+
+// BearerAuth is the name of the security scheme of the routes that require a token.
+const BearerAuth = "BearerAuth"
+
+// Document is the model of an OpenAPI document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info is the model of the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem is the model of the operations of a path by lower case method.
+type PathItem map[string]*Operation
+
+// Operation is the model of an operation of a path.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter is the model of a parameter of an operation.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the model of the body of the request of an operation.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is the model of a response of an operation.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the model of the schema of a body by media type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components is the model of the schemas and the security schemes referenced by the operations.
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme is the model of a security scheme.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Generate is the function that generates the OpenAPI document of the routes.
+// Each route is an operation of its full path, whose bodies are described by the schemas of the types of its doc,
+// and the error responses of every operation are described by the problem and the legacy error models.
+// The routes mounted by path prefix, such as the documentation ones, are not operations of the API and are skipped.
+func Generate(info Info, routes routehttputilpkg.Routes) *Document {
+	generator := newGenerator()
+
+	document := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: generator.schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				BearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	errorResponse := Response{
+		Description: "Error",
+		Content: map[string]MediaType{
+			responsehttputilpkg.ContentTypeProblemJSON: {Schema: generator.schemaOf(reflect.TypeOf(responsehttputilpkg.Problem{}))},
+			responsehttputilpkg.ContentTypeJSON:        {Schema: generator.schemaOf(reflect.TypeOf(responsehttputilpkg.Error{}))},
+		},
+	}
+
+	operationIDs := make(map[string]int)
+
+	for _, route := range routes {
+		if route.Path == "" {
+			continue
+		}
+
+		doc := route.Doc
+		if doc == nil {
+			doc = &routehttputilpkg.Doc{}
+		}
+
+		// The aliases of a route share its name, so that the IDs of their operations are numbered.
+		operationID := route.Name
+		if count := operationIDs[route.Name]; count > 0 {
+			operationID += strconv.Itoa(count + 1)
+		}
+		operationIDs[route.Name]++
+
+		operation := &Operation{
+			OperationID: operationID,
+			Summary:     doc.Summary,
+			Tags:        doc.Tags,
+			Deprecated:  route.Deprecation != nil,
+			Responses:   map[string]Response{"default": errorResponse},
+		}
+
+		headerNames := make([]string, 0, len(doc.Headers))
+		for name := range doc.Headers {
+			headerNames = append(headerNames, name)
+		}
+		sort.Strings(headerNames)
+
+		for _, name := range headerNames {
+			operation.Parameters = append(operation.Parameters, Parameter{
+				Name:        name,
+				In:          "header",
+				Description: doc.Headers[name],
+				Schema:      &Schema{Type: "string"},
+			})
+		}
+
+		if doc.Request != nil {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]MediaType{
+					responsehttputilpkg.ContentTypeJSON: {Schema: generator.schemaOf(reflect.TypeOf(doc.Request))},
+				},
+			}
+		}
+
+		for statusCode, body := range doc.Responses {
+			response := Response{Description: http.StatusText(statusCode)}
+
+			if body != nil {
+				response.Content = map[string]MediaType{
+					responsehttputilpkg.ContentTypeJSON: {Schema: generator.schemaOf(reflect.TypeOf(body))},
+				}
+			}
+
+			operation.Responses[strconv.Itoa(statusCode)] = response
+		}
+
+		if route.Auth != routehttputilpkg.AuthNone {
+			operation.Security = []map[string][]string{{BearerAuth: {}}}
+		}
+
+		path := route.FullPath()
+		if _, ok := document.Paths[path]; !ok {
+			document.Paths[path] = make(PathItem)
+		}
+
+		document.Paths[path][strings.ToLower(route.Method)] = operation
+	}
+
+	return document
+}
+
+// Operation is the function that finds the operation of a method and a path, if any.
+func (d *Document) Operation(method string, path string) (*Operation, bool) {
+	operation, ok := d.Paths[path][strings.ToLower(method)]
+	return operation, ok
+}
+
+// Handler is the function that serves the document as JSON.
+func Handler(document *Document) http.HandlerFunc {
+	content, err := json.Marshal(document)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			responsehttputilpkg.RespondErrorWithJSON(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+	}
+}
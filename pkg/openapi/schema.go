@@ -0,0 +1,211 @@
+package openapi
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is the model of a JSON schema, as used by OpenAPI 3.1.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+}
+
+// Rule is the function that applies a validation rule of the validate tag of a field, along with its parameter, to its schema.
+type Rule func(schema *Schema, param string)
+
+// Rules are the validation rules of the validate tags translated into the schemas by name.
+// The rules that are not found, as well as the ones whose constraints cannot be expressed by a schema, are ignored.
+var Rules = map[string]Rule{
+	"nonzero": func(schema *Schema, param string) {
+		if schema.Type == "string" {
+			schema.MinLength = intPtr(1)
+		}
+	},
+	"min": func(schema *Schema, param string) {
+		bound(schema, param, func(s *Schema, n int) { s.MinLength = &n }, func(s *Schema, f float64) { s.Minimum = &f })
+	},
+	"max": func(schema *Schema, param string) {
+		bound(schema, param, func(s *Schema, n int) { s.MaxLength = &n }, func(s *Schema, f float64) { s.Maximum = &f })
+	},
+	"len": func(schema *Schema, param string) {
+		bound(schema, param, func(s *Schema, n int) { s.MinLength = &n; s.MaxLength = intPtr(n) }, func(s *Schema, f float64) {})
+	},
+	"regexp": func(schema *Schema, param string) {
+		schema.Pattern = param
+	},
+	"uuid": func(schema *Schema, param string) {
+		schema.Format = "uuid"
+	},
+	"username": func(schema *Schema, param string) {
+		schema.Pattern = "^[a-zA-Z0-9]{5,}$"
+	},
+	"password": func(schema *Schema, param string) {
+		schema.Pattern = "^[a-zA-Z0-9]{8,}$"
+	},
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// generator is the component that generates the schemas of the types, keeping the ones of the named structs as components.
+type generator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+// newGenerator is the factory function that encapsulates the implementation related to the generator of schemas.
+func newGenerator() *generator {
+	return &generator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaOf is the function that generates the schema of a type.
+// A named struct is described by a component, which the returned schema references.
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		schema := &Schema{Type: "string"}
+		if strings.HasSuffix(t.PkgPath(), "uuid") {
+			schema.Format = "uuid"
+		}
+
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+
+		return &Schema{Ref: "#/components/schemas/" + g.component(t)}
+	default:
+		// The interfaces accept any value.
+		return &Schema{}
+	}
+}
+
+// component is the function that returns the name of the component of a named struct, generating it the first time.
+// The name of the type is preceded by the one of its package when another type already has it.
+func (g *generator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, ok := g.schemas[name]; ok {
+		pkgPath := strings.Split(t.PkgPath(), "/")
+		name = pkgPath[len(pkgPath)-1] + "." + name
+	}
+
+	g.names[t] = name
+	// The component is reserved before its fields are generated, so that the recursive types refer to it.
+	g.schemas[name] = &Schema{}
+	*g.schemas[name] = *g.structSchema(t)
+
+	return name
+}
+
+// structSchema is the function that generates the schema of the fields of a struct by their JSON names.
+// A field is required if it is not omitted when empty and has no validate tag, as the fields of the responses,
+// or if its validate tag has the nonzero rule, as the fields of the requests.
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema), AdditionalProperties: false}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldSchema := g.schemaOf(field.Type)
+
+		validateTag, hasValidateTag := field.Tag.Lookup("validate")
+
+		required := !hasValidateTag && !strings.Contains(options, "omitempty")
+
+		for _, rule := range strings.Split(validateTag, ",") {
+			ruleName, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+			if ruleName == "nonzero" {
+				required = true
+			}
+
+			if apply, ok := Rules[ruleName]; ok && fieldSchema.Ref == "" {
+				apply(fieldSchema, param)
+			}
+		}
+
+		schema.Properties[name] = fieldSchema
+
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// bound is the function that applies a bound to the length of a string or an array or to the value of a number.
+func bound(schema *Schema, param string, length func(*Schema, int), value func(*Schema, float64)) {
+	switch schema.Type {
+	case "string", "array":
+		if n, err := strconv.Atoi(param); err == nil {
+			length(schema, n)
+		}
+	case "integer", "number":
+		if f, err := strconv.ParseFloat(param, 64); err == nil {
+			value(schema, f)
+		}
+	}
+}
+
+// intPtr is the function that returns a pointer to an int.
+func intPtr(n int) *int {
+	return &n
+}
@@ -0,0 +1,145 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	tokenhttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/token"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestGenerate() {
+	routes := routehttputilpkg.Routes{}
+
+	path := ""
+
+	method := ""
+
+	operation := openapipkg.Operation{}
+
+	paths := 0
+
+	components := map[string]*openapipkg.Schema{}
+
+	minLength := 1
+
+	info := openapipkg.Info{Title: "API", Version: "1.0"}
+
+	signInRoute := routehttputilpkg.Route{
+		Name:    "SignIn",
+		Method:  http.MethodPost,
+		Version: routehttputilpkg.V1,
+		Path:    "/sign_in",
+		Doc: &routehttputilpkg.Doc{
+			Summary:   "API endpoint to perform sign in.",
+			Tags:      []string{"authentication"},
+			Request:   securitypkg.Credentials{},
+			Headers:   map[string]string{"If-Match": "Entity tag"},
+			Responses: map[int]interface{}{http.StatusOK: tokenhttputilpkg.Token{}},
+		},
+	}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInGeneratingTheOperationOfARouteFromItsDoc",
+			SetUp: func(t *testing.T) {
+				routes = routehttputilpkg.Routes{
+					signInRoute,
+					{Name: "Swagger", Method: http.MethodGet, PathPrefix: "/swagger"},
+				}
+				paths = 1
+				path = "/v1/sign_in"
+				method = http.MethodPost
+				operation = openapipkg.Operation{
+					OperationID: "SignIn",
+					Summary:     "API endpoint to perform sign in.",
+					Tags:        []string{"authentication"},
+					Parameters: []openapipkg.Parameter{
+						{Name: "If-Match", In: "header", Description: "Entity tag", Schema: &openapipkg.Schema{Type: "string"}},
+					},
+					RequestBody: &openapipkg.RequestBody{
+						Required: true,
+						Content: map[string]openapipkg.MediaType{
+							"application/json": {Schema: &openapipkg.Schema{Ref: "#/components/schemas/Credentials"}},
+						},
+					},
+				}
+				components = map[string]*openapipkg.Schema{
+					"Credentials": {
+						Type: "object",
+						Properties: map[string]*openapipkg.Schema{
+							"username": {Type: "string", MinLength: &minLength, Pattern: "^[a-zA-Z0-9]{5,}$"},
+							"password": {Type: "string", MinLength: &minLength, Pattern: "^[a-zA-Z0-9]{8,}$"},
+						},
+						Required:             []string{"username", "password"},
+						AdditionalProperties: false,
+					},
+					"Token": {
+						Type:                 "object",
+						Properties:           map[string]*openapipkg.Schema{"token": {Type: "string"}},
+						Required:             []string{"token"},
+						AdditionalProperties: false,
+					},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInGeneratingTheOperationOfADeprecatedAuthenticatedAlias",
+			SetUp: func(t *testing.T) {
+				signOutRoute := routehttputilpkg.Route{
+					Name:    "SignOut",
+					Method:  http.MethodPost,
+					Version: routehttputilpkg.V1,
+					Path:    "/sign_out",
+					Auth:    routehttputilpkg.AuthToken,
+				}
+				routes = routehttputilpkg.Routes{signOutRoute}.WithUnversionedAliases(routehttputilpkg.Deprecation{})
+				paths = 2
+				path = "/sign_out"
+				method = http.MethodPost
+				operation = openapipkg.Operation{
+					OperationID: "SignOut2",
+					Deprecated:  true,
+					Security:    []map[string][]string{{openapipkg.BearerAuth: {}}},
+				}
+				components = map[string]*openapipkg.Schema{}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			document := openapipkg.Generate(info, routes)
+
+			assert.Equal(t, openapipkg.Version, document.OpenAPI)
+			assert.Equal(t, info, document.Info)
+			assert.Len(t, document.Paths, paths)
+
+			returnedOperation, ok := document.Operation(method, path)
+			assert.True(t, ok)
+
+			assert.Contains(t, returnedOperation.Responses, "default")
+
+			if operation.RequestBody != nil {
+				assert.Equal(t, "OK", returnedOperation.Responses["200"].Description)
+				assert.Equal(t, &openapipkg.Schema{Ref: "#/components/schemas/Token"},
+					returnedOperation.Responses["200"].Content["application/json"].Schema)
+			}
+
+			returnedOperation.Responses = nil
+			assert.Equal(t, operation, *returnedOperation)
+
+			assert.Contains(t, document.Components.Schemas, "Problem")
+			assert.Contains(t, document.Components.Schemas, "Error")
+
+			for name, schema := range components {
+				assert.Equal(t, schema, document.Components.Schemas[name])
+			}
+		})
+	}
+}
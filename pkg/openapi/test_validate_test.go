@@ -0,0 +1,94 @@
+package openapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
+	openapipkg "github.com/icaroribeiro/go-code-challenge-template/pkg/openapi"
+	securitypkg "github.com/icaroribeiro/go-code-challenge-template/pkg/security"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestValidate() {
+	data := ""
+
+	fieldErrors := []customerror.FieldError{}
+
+	routes := routehttputilpkg.Routes{
+		{
+			Name:   "SignUp",
+			Method: http.MethodPost,
+			Path:   "/sign_up",
+			Doc:    &routehttputilpkg.Doc{Request: securitypkg.Credentials{}},
+		},
+	}
+
+	document := openapipkg.Generate(openapipkg.Info{}, routes)
+
+	operation, _ := document.Operation(http.MethodPost, "/sign_up")
+
+	schema := operation.RequestBody.Content["application/json"].Schema
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInValidatingAValueThatMatchesTheSchema",
+			SetUp: func(t *testing.T) {
+				data = `{"username": "username", "password": "password"}`
+				fieldErrors = []customerror.FieldError{}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInReportingTheViolatedConstraintsByField",
+			SetUp: func(t *testing.T) {
+				data = `{"username": "user", "admin": true}`
+				fieldErrors = []customerror.FieldError{
+					{Field: "password", Rule: "required", Message: "must be informed"},
+					{Field: "admin", Rule: "additionalProperties", Message: "is not allowed"},
+					{
+						Field:   "username",
+						Rule:    "pattern",
+						Params:  map[string]string{"pattern": "^[a-zA-Z0-9]{5,}$"},
+						Message: "must match the pattern ^[a-zA-Z0-9]{5,}$",
+					},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInReportingAValueOfTheWrongType",
+			SetUp: func(t *testing.T) {
+				data = `{"username": 12345, "password": "password"}`
+				fieldErrors = []customerror.FieldError{
+					{Field: "username", Rule: "type", Params: map[string]string{"type": "string"}, Message: "must be of type string"},
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheValueIsNotJSON",
+			SetUp: func(t *testing.T) {
+				data = `{"username":`
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedFieldErrors, err := document.Validate(schema, []byte(data))
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, fieldErrors, returnedFieldErrors)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
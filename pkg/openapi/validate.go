@@ -0,0 +1,202 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Validate is the function that validates a JSON value against a schema of the document, whose references
+// are resolved from its components. Each violated constraint is reported as the error of the field at its JSON path,
+// such as credentials.username or items[0].name, along with the keyword of the constraint as the rule.
+func (d *Document) Validate(schema *Schema, data []byte) ([]customerror.FieldError, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to decode the JSON value: %s", err.Error())
+	}
+
+	fieldErrors := make([]customerror.FieldError, 0)
+	d.validate(schema, value, "", &fieldErrors)
+
+	return fieldErrors, nil
+}
+
+// validate is the function that validates a value against a schema, appending the violations to the errors of the fields.
+func (d *Document) validate(schema *Schema, value interface{}, path string, fieldErrors *[]customerror.FieldError) {
+	if schema == nil {
+		return
+	}
+
+	if schema.Ref != "" {
+		d.validate(d.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")], value, path, fieldErrors)
+		return
+	}
+
+	violate := func(rule string, params map[string]string, msg string, args ...interface{}) {
+		*fieldErrors = append(*fieldErrors, customerror.FieldError{
+			Field:   path,
+			Rule:    rule,
+			Params:  params,
+			Message: fmt.Sprintf(msg, args...),
+		})
+	}
+
+	if schema.Type != nil && !hasType(schema.Type, value) {
+		violate("type", map[string]string{"type": fmt.Sprint(schema.Type)}, "must be of type %v", schema.Type)
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				*fieldErrors = append(*fieldErrors, customerror.FieldError{
+					Field:   join(path, name),
+					Rule:    "required",
+					Message: "must be informed",
+				})
+			}
+		}
+
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if propertySchema, ok := schema.Properties[name]; ok {
+				d.validate(propertySchema, v[name], join(path, name), fieldErrors)
+				continue
+			}
+
+			switch additionalProperties := schema.AdditionalProperties.(type) {
+			case bool:
+				if !additionalProperties {
+					*fieldErrors = append(*fieldErrors, customerror.FieldError{
+						Field:   join(path, name),
+						Rule:    "additionalProperties",
+						Message: "is not allowed",
+					})
+				}
+			case *Schema:
+				d.validate(additionalProperties, v[name], join(path, name), fieldErrors)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			d.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), fieldErrors)
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+
+		if schema.MinLength != nil && length < *schema.MinLength {
+			violate("minLength", map[string]string{"minLength": strconv.Itoa(*schema.MinLength)}, "must have at least %d characters", *schema.MinLength)
+		}
+
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			violate("maxLength", map[string]string{"maxLength": strconv.Itoa(*schema.MaxLength)}, "must have at most %d characters", *schema.MaxLength)
+		}
+
+		if schema.Pattern != "" {
+			if matched, err := regexp.MatchString(schema.Pattern, v); err == nil && !matched {
+				violate("pattern", map[string]string{"pattern": schema.Pattern}, "must match the pattern %s", schema.Pattern)
+			}
+		}
+
+		if !hasFormat(schema.Format, v) {
+			violate("format", map[string]string{"format": schema.Format}, "must be in the %s format", schema.Format)
+		}
+	case json.Number:
+		number, err := v.Float64()
+		if err != nil {
+			return
+		}
+
+		if schema.Minimum != nil && number < *schema.Minimum {
+			violate("minimum", map[string]string{"minimum": fmt.Sprint(*schema.Minimum)}, "must be at least %v", *schema.Minimum)
+		}
+
+		if schema.Maximum != nil && number > *schema.Maximum {
+			violate("maximum", map[string]string{"maximum": fmt.Sprint(*schema.Maximum)}, "must be at most %v", *schema.Maximum)
+		}
+	}
+}
+
+// hasType is the function that checks if a value has one of the types of a schema.
+func hasType(schemaType interface{}, value interface{}) bool {
+	types, ok := schemaType.([]string)
+	if !ok {
+		types = []string{fmt.Sprint(schemaType)}
+	}
+
+	for _, t := range types {
+		switch v := value.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			}
+
+			if _, err := v.Int64(); t == "integer" && err == nil {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasFormat is the function that checks if a string has the format of a schema. The unknown formats are not checked.
+func hasFormat(format string, value string) bool {
+	switch format {
+	case "uuid":
+		_, err := uuid.FromString(value)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// join is the function that joins the JSON path of an object with the name of one of its properties.
+func join(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
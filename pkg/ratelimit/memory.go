@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval between the removals of the buckets that are full again.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	fullAt time.Time
+}
+
+// MemoryStore is the store that keeps the token buckets in the process memory.
+// It is suitable for a single replica, since the buckets are not shared.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryStore is the factory function that encapsulates the implementation related to the in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take is the function that takes a token from the bucket of the key.
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: policy.capacity(), last: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = policy.refill(b.tokens, now.Sub(b.last))
+	b.last = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	result := policy.result(allowed, b.tokens)
+	b.fullAt = now.Add(result.ResetAfter)
+
+	return result, nil
+}
+
+// Close is the function that releases the buckets.
+func (s *MemoryStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buckets = make(map[string]*bucket)
+
+	return nil
+}
+
+// sweep is the function that removes the buckets that are full again, since they are
+// equivalent to the ones that were never used. It must be called with the lock held.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+
+	s.lastSweep = now
+}
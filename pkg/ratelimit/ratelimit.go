@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy is the token bucket that throttles a key: it holds up to Burst tokens and
+// is refilled with Limit tokens every Period. Every request takes one token.
+type Policy struct {
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// Store is the interface that keeps the state of the token buckets.
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy) (Result, error)
+	Close() error
+}
+
+// ParsePolicy is the function that parses a policy written as <limit>/<period>[/<burst>], such as 5/1m or 100/1h/20.
+// When the burst is omitted, it is the same as the limit.
+func ParsePolicy(value string) (Policy, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return Policy{}, fmt.Errorf("the policy %q is not in the <limit>/<period>[/<burst>] format", value)
+	}
+
+	limit, err := strconv.Atoi(parts[0])
+	if err != nil || limit <= 0 {
+		return Policy{}, fmt.Errorf("the limit of the policy %q must be a positive integer", value)
+	}
+
+	period, err := time.ParseDuration(parts[1])
+	if err != nil || period <= 0 {
+		return Policy{}, fmt.Errorf("the period of the policy %q must be a positive duration", value)
+	}
+
+	burst := limit
+
+	if len(parts) == 3 {
+		burst, err = strconv.Atoi(parts[2])
+		if err != nil || burst <= 0 {
+			return Policy{}, fmt.Errorf("the burst of the policy %q must be a positive integer", value)
+		}
+	}
+
+	return Policy{Limit: limit, Period: period, Burst: burst}, nil
+}
+
+// capacity is the function that returns the maximum number of tokens of the bucket.
+func (p Policy) capacity() float64 {
+	if p.Burst > 0 {
+		return float64(p.Burst)
+	}
+
+	return float64(p.Limit)
+}
+
+// rate is the function that returns the number of tokens added to the bucket per nanosecond.
+func (p Policy) rate() float64 {
+	return float64(p.Limit) / float64(p.Period)
+}
+
+// refill is the function that returns the tokens of the bucket after the elapsed time.
+func (p Policy) refill(tokens float64, elapsed time.Duration) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(p.capacity(), tokens+float64(elapsed)*p.rate())
+}
+
+// result is the function that builds the result from the tokens left in the bucket.
+func (p Policy) result(allowed bool, tokens float64) Result {
+	result := Result{
+		Allowed:    allowed,
+		Limit:      int(p.capacity()),
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: time.Duration(math.Ceil((p.capacity() - tokens) / p.rate())),
+	}
+
+	if !allowed {
+		result.RetryAfter = time.Duration(math.Ceil((1 - tokens) / p.rate()))
+	}
+
+	return result
+}
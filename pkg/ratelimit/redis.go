@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix is the prefix of the keys of the buckets kept in Redis.
+const keyPrefix = "ratelimit:"
+
+// takeScript refills and takes a token from the bucket atomically using the clock of the Redis server,
+// so that the replicas of the application share the same buckets regardless of their own clocks.
+// The tokens are returned as a string, since Redis truncates the Lua numbers to integers.
+var takeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.max(1, math.ceil((capacity - tokens) / rate / 1000)))
+
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore is the store that keeps the token buckets in Redis, so that they are shared by all replicas.
+type RedisStore struct {
+	Client redis.UniversalClient
+}
+
+// NewRedisStore is the factory function that encapsulates the implementation related to the Redis store.
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{
+		Client: client,
+	}
+}
+
+// Take is the function that takes a token from the bucket of the key.
+func (s *RedisStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	// The rate is sent in tokens per microsecond, which is the resolution of the Redis clock.
+	rate := policy.rate() * 1000
+
+	values, err := takeScript.Run(ctx, s.Client, []string{keyPrefix + key},
+		policy.capacity(), strconv.FormatFloat(rate, 'g', -1, 64)).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take a token from the bucket: %s", err.Error())
+	}
+
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("failed to take a token from the bucket: unexpected reply %v", values)
+	}
+
+	allowed, ok := values[0].(int64)
+	if !ok {
+		return Result{}, fmt.Errorf("failed to take a token from the bucket: unexpected reply %v", values)
+	}
+
+	tokensString, ok := values[1].(string)
+	if !ok {
+		return Result{}, fmt.Errorf("failed to take a token from the bucket: unexpected reply %v", values)
+	}
+
+	tokens, err := strconv.ParseFloat(tokensString, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take a token from the bucket: %s", err.Error())
+	}
+
+	return policy.result(allowed == 1, tokens), nil
+}
+
+// Close is the function that closes the Redis client.
+func (s *RedisStore) Close() error {
+	return s.Client.Close()
+}
+
+// Name is the function that returns the name of the store checked by the readiness check.
+func (s *RedisStore) Name() string {
+	return "ratelimit_redis"
+}
+
+// Check is the function that checks if Redis is reachable.
+func (s *RedisStore) Check(ctx context.Context) error {
+	return s.Client.Ping(ctx).Err()
+}
@@ -0,0 +1,93 @@
+package ratelimit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestMemoryStoreTake() {
+	ctx := context.Background()
+
+	key := "SignIn:ip:192.0.2.1"
+
+	policy := ratelimitpkg.Policy{}
+
+	takes := 0
+
+	wait := time.Duration(0)
+
+	result := ratelimitpkg.Result{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAllowingTheRequestsWithinTheBurst",
+			SetUp: func(t *testing.T) {
+				policy = ratelimitpkg.Policy{Limit: 2, Period: time.Minute, Burst: 2}
+				takes = 2
+				wait = 0
+				result = ratelimitpkg.Result{Allowed: true, Limit: 2, Remaining: 0}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInRejectingTheRequestsBeyondTheBurst",
+			SetUp: func(t *testing.T) {
+				policy = ratelimitpkg.Policy{Limit: 2, Period: time.Minute, Burst: 2}
+				takes = 3
+				wait = 0
+				result = ratelimitpkg.Result{Allowed: false, Limit: 2, Remaining: 0}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInAllowingTheRequestsAgainAfterTheBucketIsRefilled",
+			SetUp: func(t *testing.T) {
+				policy = ratelimitpkg.Policy{Limit: 1, Period: 50 * time.Millisecond, Burst: 1}
+				takes = 1
+				wait = 60 * time.Millisecond
+				result = ratelimitpkg.Result{Allowed: true, Limit: 1, Remaining: 0}
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			store := ratelimitpkg.NewMemoryStore()
+			defer store.Close()
+
+			if wait > 0 {
+				_, err := store.Take(ctx, key, policy)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				time.Sleep(wait)
+			}
+
+			returnedResult := ratelimitpkg.Result{}
+			var err error
+
+			for i := 0; i < takes; i++ {
+				returnedResult, err = store.Take(ctx, key, policy)
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			}
+
+			assert.Equal(t, result.Allowed, returnedResult.Allowed)
+			assert.Equal(t, result.Limit, returnedResult.Limit)
+			assert.Equal(t, result.Remaining, returnedResult.Remaining)
+			assert.True(t, returnedResult.ResetAfter > 0)
+
+			if result.Allowed {
+				assert.Zero(t, returnedResult.RetryAfter)
+			} else {
+				assert.True(t, returnedResult.RetryAfter > 0 && returnedResult.RetryAfter <= policy.Period)
+			}
+
+			otherResult, err := store.Take(ctx, "SignIn:ip:192.0.2.2", policy)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.True(t, otherResult.Allowed)
+		})
+	}
+}
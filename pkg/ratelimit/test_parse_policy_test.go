@@ -0,0 +1,78 @@
+package ratelimit_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestParsePolicy() {
+	value := ""
+
+	policy := ratelimitpkg.Policy{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInParsingAPolicyWithoutBurst",
+			SetUp: func(t *testing.T) {
+				value = "5/1m"
+				policy = ratelimitpkg.Policy{Limit: 5, Period: time.Minute, Burst: 5}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInParsingAPolicyWithBurst",
+			SetUp: func(t *testing.T) {
+				value = "100/1h/20"
+				policy = ratelimitpkg.Policy{Limit: 100, Period: time.Hour, Burst: 20}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfThePolicyIsNotInTheExpectedFormat",
+			SetUp: func(t *testing.T) {
+				value = "5"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheLimitIsNotAPositiveInteger",
+			SetUp: func(t *testing.T) {
+				value = "0/1m"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfThePeriodIsNotAValidDuration",
+			SetUp: func(t *testing.T) {
+				value = "5/minute"
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheBurstIsNotAPositiveInteger",
+			SetUp: func(t *testing.T) {
+				value = "5/1m/-1"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			returnedPolicy, err := ratelimitpkg.ParsePolicy(value)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, policy, returnedPolicy)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
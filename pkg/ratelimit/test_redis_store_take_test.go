@@ -0,0 +1,106 @@
+package ratelimit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	ratelimitpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/ratelimit"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestRedisStoreTake() {
+	ctx := context.Background()
+
+	key := "ChangePassword:user:4f2d6a6e-1d3c-4d6b-9a57-0c6d1f3b8e21"
+
+	policy := ratelimitpkg.Policy{Limit: 2, Period: time.Minute, Burst: 2}
+
+	var server *miniredis.Miniredis
+
+	var store *ratelimitpkg.RedisStore
+
+	takes := 0
+
+	wait := time.Duration(0)
+
+	result := ratelimitpkg.Result{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInAllowingTheRequestsWithinTheBurst",
+			SetUp: func(t *testing.T) {
+				takes = 2
+				wait = 0
+				result = ratelimitpkg.Result{Allowed: true, Limit: 2, Remaining: 0}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRejectingTheRequestsBeyondTheBurst",
+			SetUp: func(t *testing.T) {
+				takes = 3
+				wait = 0
+				result = ratelimitpkg.Result{Allowed: false, Limit: 2, Remaining: 0}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInAllowingTheRequestsAgainAfterTheBucketIsRefilled",
+			SetUp: func(t *testing.T) {
+				takes = 3
+				wait = 30 * time.Second
+				result = ratelimitpkg.Result{Allowed: true, Limit: 2, Remaining: 0}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfRedisIsNotAvailable",
+			SetUp: func(t *testing.T) {
+				takes = 1
+				wait = 0
+				server.Close()
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			server = miniredis.RunT(t)
+			server.SetTime(time.Now())
+
+			store = ratelimitpkg.NewRedisStore(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+			defer store.Close()
+
+			tc.SetUp(t)
+
+			returnedResult := ratelimitpkg.Result{}
+			var err error
+
+			for i := 0; i < takes; i++ {
+				if wait > 0 && i == takes-1 {
+					server.SetTime(time.Now().Add(wait))
+				}
+
+				returnedResult, err = store.Take(ctx, key, policy)
+			}
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.Equal(t, result.Allowed, returnedResult.Allowed)
+				assert.Equal(t, result.Limit, returnedResult.Limit)
+				assert.Equal(t, result.Remaining, returnedResult.Remaining)
+				if !result.Allowed {
+					assert.True(t, returnedResult.RetryAfter > 0 && returnedResult.RetryAfter <= policy.Period)
+				}
+				assert.True(t, server.TTL("ratelimit:"+key) > 0)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package security
 
 import (
+	"time"
+
 	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	metricspkg "github.com/icaroribeiro/go-code-challenge-template/pkg/metrics"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -18,6 +21,11 @@ func (s *Security) HashPassword(password string, cost int) ([]byte, error) {
 		return []byte{}, customerror.BadRequest.New("the password is empty")
 	}
 
+	start := time.Now()
+	defer func() {
+		metricspkg.PasswordHashingDuration.Observe(time.Since(start).Seconds())
+	}()
+
 	return bcrypt.GenerateFromPassword([]byte(password), cost)
 }
 
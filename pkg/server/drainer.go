@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// Drainer is the component that tracks whether the application is draining before shutting down.
+// While draining, the readiness check fails so that the load balancers stop routing new requests,
+// and the servers keep handling the ones that are still arriving.
+type Drainer struct {
+	draining atomic.Bool
+}
+
+// NewDrainer is the factory function that encapsulates the implementation related to drainer.
+func NewDrainer() *Drainer {
+	return &Drainer{}
+}
+
+// IsDraining is the function that checks if the application is draining.
+func (d *Drainer) IsDraining() bool {
+	return d.draining.Load()
+}
+
+// Drain is the function that marks the application as draining and waits for the period,
+// or until the context is done, so that the failing readiness is noticed before the servers stop.
+func (d *Drainer) Drain(ctx context.Context, period time.Duration) {
+	d.draining.Store(true)
+
+	if period <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(period)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// Name is the function that returns the name of the component checked by the readiness check.
+func (d *Drainer) Name() string {
+	return "shutdown"
+}
+
+// Check is the function that fails while the application is draining.
+func (d *Drainer) Check(ctx context.Context) error {
+	if d.IsDraining() {
+		return errors.New("the application is shutting down")
+	}
+
+	return nil
+}
@@ -2,29 +2,215 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
+// systemdListenFDsStart is the first file descriptor passed by systemd socket activation.
+const systemdListenFDsStart = 3
+
+// Options is the set of settings of the server.
+type Options struct {
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	MaxHeaderBytes    int
+	// H2C enables HTTP/2 over cleartext connections, as used inside service meshes. It is ignored when serving HTTPS.
+	H2C bool
+	// UnixSocket is the path of the Unix domain socket to listen on instead of the TCP address.
+	UnixSocket string
+	// SystemdSocket makes the server listen on the socket inherited from systemd socket activation instead of the TCP address.
+	SystemdSocket bool
+	// TLS enables HTTPS when it is informed.
+	TLS *TLSConfig
+}
+
+// DefaultOptions is the function that returns the settings that protect the server against slow clients.
+func DefaultOptions() Options {
+	return Options{
+		ReadTimeout:       15 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		MaxHeaderBytes:    1 << 20,
+	}
+}
+
 type Server struct {
-	Instance *http.Server
+	Instance      *http.Server
+	unixSocket    string
+	systemdSocket bool
+	certReloader  *certReloader
+	done          chan struct{}
+	stopOnce      sync.Once
 }
 
 // New is the factory function that encapsulates the implementation related to server.
 func New(tcpAddress string, handler http.Handler) *Server {
-	return &Server{
+	server, _ := NewWithOptions(tcpAddress, handler, DefaultOptions())
+	return server
+}
+
+// NewWithTLS is the factory function that encapsulates the implementation related to server serving HTTPS.
+func NewWithTLS(tcpAddress string, handler http.Handler, config TLSConfig) (*Server, error) {
+	options := DefaultOptions()
+	options.TLS = &config
+
+	return NewWithOptions(tcpAddress, handler, options)
+}
+
+// NewWithOptions is the factory function that encapsulates the implementation related to server using the given settings.
+func NewWithOptions(tcpAddress string, handler http.Handler, options Options) (*Server, error) {
+	if options.UnixSocket != "" && options.SystemdSocket {
+		return nil, fmt.Errorf("the server cannot listen on both a Unix socket and a systemd socket")
+	}
+
+	if options.H2C && options.TLS == nil {
+		handler = h2c.NewHandler(handler, &http2.Server{IdleTimeout: options.IdleTimeout})
+	}
+
+	server := &Server{
 		Instance: &http.Server{
-			Addr:    tcpAddress,
-			Handler: handler,
+			Addr:              tcpAddress,
+			Handler:           handler,
+			ReadTimeout:       options.ReadTimeout,
+			ReadHeaderTimeout: options.ReadHeaderTimeout,
+			WriteTimeout:      options.WriteTimeout,
+			IdleTimeout:       options.IdleTimeout,
+			MaxHeaderBytes:    options.MaxHeaderBytes,
 		},
+		unixSocket:    options.UnixSocket,
+		systemdSocket: options.SystemdSocket,
 	}
+
+	if options.TLS != nil {
+		certReloader, err := newCertReloader(*options.TLS)
+		if err != nil {
+			return nil, err
+		}
+
+		server.certReloader = certReloader
+		server.done = make(chan struct{})
+		server.Instance.TLSConfig = &tls.Config{
+			MinVersion:         certReloader.minVersion,
+			GetCertificate:     certReloader.getCertificate,
+			GetConfigForClient: certReloader.getConfigForClient,
+		}
+	}
+
+	return server, nil
+}
+
+// IsTLS is the function that checks if the server serves HTTPS.
+func (s *Server) IsTLS() bool {
+	return s.certReloader != nil
+}
+
+// Address is the function that returns the address the server listens on.
+func (s *Server) Address() string {
+	switch {
+	case s.unixSocket != "":
+		return "unix:" + s.unixSocket
+	case s.systemdSocket:
+		return "systemd"
+	default:
+		return s.Instance.Addr
+	}
+}
+
+// listen is the function that opens the listener of the server.
+func (s *Server) listen() (net.Listener, error) {
+	switch {
+	case s.unixSocket != "":
+		// A socket file left behind by a previous run would make the listening fail.
+		if info, err := os.Stat(s.unixSocket); err == nil && info.Mode()&os.ModeSocket != 0 {
+			if err = os.Remove(s.unixSocket); err != nil {
+				return nil, fmt.Errorf("failed to remove the stale Unix socket: %s", err.Error())
+			}
+		}
+
+		return net.Listen("unix", s.unixSocket)
+	case s.systemdSocket:
+		return systemdListener()
+	default:
+		addr := s.Instance.Addr
+		if addr == "" {
+			addr = ":http"
+		}
+
+		return net.Listen("tcp", addr)
+	}
+}
+
+// systemdListener is the function that gets the first socket passed by systemd socket activation.
+func systemdListener() (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, fmt.Errorf("no socket was passed by systemd to this process")
+	}
+
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return nil, fmt.Errorf("no socket was passed by systemd to this process")
+	}
+
+	// The variables are unset so that they are not inherited by child processes.
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	file := os.NewFile(uintptr(systemdListenFDsStart), "systemd-socket")
+	defer file.Close()
+
+	listener, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on the systemd socket: %s", err.Error())
+	}
+
+	return listener, nil
 }
 
 // Start is the function that starts the server.
+// When serving HTTPS, the TLS settings are reloaded as their files change until the server is stopped.
 func (s *Server) Start() error {
-	return s.Instance.ListenAndServe()
+	listener, err := s.listen()
+	if err != nil {
+		return err
+	}
+
+	if s.certReloader == nil {
+		return s.Instance.Serve(listener)
+	}
+
+	go s.certReloader.watch(s.done)
+
+	return s.Instance.ServeTLS(listener, "", "")
+}
+
+// Reload is the function that reloads the TLS settings from their files.
+func (s *Server) Reload() error {
+	if s.certReloader == nil {
+		return nil
+	}
+
+	return s.certReloader.reload()
 }
 
 // Stop is the function that stops the server.
 func (s *Server) Stop(ctx context.Context) error {
+	if s.done != nil {
+		s.stopOnce.Do(func() { close(s.done) })
+	}
+
 	return s.Instance.Shutdown(ctx)
 }
@@ -0,0 +1,68 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	serverpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestDrain() {
+	period := time.Duration(0)
+
+	var ctx context.Context
+
+	var cancel context.CancelFunc
+
+	minElapsed := time.Duration(0)
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInDrainingForThePeriod",
+			SetUp: func(t *testing.T) {
+				period = 50 * time.Millisecond
+				ctx, cancel = context.WithCancel(context.Background())
+				minElapsed = period
+			},
+			TearDown: func(t *testing.T) {
+				cancel()
+			},
+		},
+		{
+			Context: "ItShouldSucceedInStoppingTheDrainWhenTheContextIsDone",
+			SetUp: func(t *testing.T) {
+				period = time.Minute
+				ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+				minElapsed = 0
+			},
+			TearDown: func(t *testing.T) {
+				cancel()
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			drainer := serverpkg.NewDrainer()
+			assert.False(t, drainer.IsDraining())
+			assert.Nil(t, drainer.Check(context.Background()))
+
+			start := time.Now()
+
+			drainer.Drain(ctx, period)
+
+			elapsed := time.Since(start)
+
+			assert.True(t, drainer.IsDraining())
+			assert.NotNil(t, drainer.Check(context.Background()), "Predicted error lost.")
+			assert.GreaterOrEqual(t, elapsed, minElapsed)
+			assert.Less(t, elapsed, 10*time.Second)
+
+			tc.TearDown(t)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package server_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	serverpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+)
+
+func (ts *TestSuite) TestNewWithOptions() {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Proto))
+	})
+
+	address := ""
+
+	options := serverpkg.Options{}
+
+	var client *http.Client
+
+	target := ""
+
+	proto := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInServingHTTP1WithTheTimeouts",
+			SetUp: func(t *testing.T) {
+				address = freeAddress(t)
+				options = serverpkg.DefaultOptions()
+				client = &http.Client{}
+				target = fmt.Sprintf("http://%s/", address)
+				proto = "HTTP/1.1"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInServingHTTP2OverCleartext",
+			SetUp: func(t *testing.T) {
+				address = freeAddress(t)
+				options = serverpkg.DefaultOptions()
+				options.H2C = true
+				client = &http.Client{
+					Transport: &http2.Transport{
+						AllowHTTP: true,
+						DialTLSContext: func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+							return (&net.Dialer{}).DialContext(ctx, network, addr)
+						},
+					},
+				}
+				target = fmt.Sprintf("http://%s/", address)
+				proto = "HTTP/2.0"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInServingOnAUnixSocket",
+			SetUp: func(t *testing.T) {
+				socket := filepath.Join(t.TempDir(), "api.sock")
+				address = ""
+				options = serverpkg.DefaultOptions()
+				options.UnixSocket = socket
+				client = &http.Client{
+					Transport: &http.Transport{
+						DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+							return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+						},
+					},
+				}
+				target = "http://unix/"
+				proto = "HTTP/1.1"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfBothAUnixSocketAndASystemdSocketAreInformed",
+			SetUp: func(t *testing.T) {
+				options = serverpkg.DefaultOptions()
+				options.UnixSocket = filepath.Join(t.TempDir(), "api.sock")
+				options.SystemdSocket = true
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			server, err := serverpkg.NewWithOptions(address, handler, options)
+
+			if tc.WantError {
+				assert.NotNil(t, err, "Predicted error lost.")
+				return
+			}
+
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, options.ReadTimeout, server.Instance.ReadTimeout)
+			assert.Equal(t, options.ReadHeaderTimeout, server.Instance.ReadHeaderTimeout)
+			assert.Equal(t, options.WriteTimeout, server.Instance.WriteTimeout)
+			assert.Equal(t, options.IdleTimeout, server.Instance.IdleTimeout)
+			assert.Equal(t, options.MaxHeaderBytes, server.Instance.MaxHeaderBytes)
+
+			serverDone := make(chan struct{})
+
+			go func() {
+				defer close(serverDone)
+				server.Start()
+			}()
+
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+				defer cancel()
+				server.Stop(ctx)
+				<-serverDone
+			}()
+
+			var resp *http.Response
+			for i := 0; i < 50; i++ {
+				if resp, err = client.Get(target); err == nil {
+					break
+				}
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, proto, string(body))
+		})
+	}
+}
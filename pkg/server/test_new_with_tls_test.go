@@ -0,0 +1,186 @@
+package server_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	serverpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/server"
+	"github.com/stretchr/testify/assert"
+)
+
+type certificate struct {
+	Cert     *x509.Certificate
+	Key      *ecdsa.PrivateKey
+	CertFile string
+	KeyFile  string
+}
+
+// writeCertificate is the function that creates a certificate signed by the parent one, or a self-signed CA
+// when the parent is nil, and writes it along with its key to PEM files in the directory.
+func writeCertificate(t *testing.T, dir string, commonName string, parent *certificate) *certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.Cert, parent.Key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	certFile := filepath.Join(dir, commonName+".crt")
+	err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	keyFile := filepath.Join(dir, commonName+".key")
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+	return &certificate{Cert: cert, Key: key, CertFile: certFile, KeyFile: keyFile}
+}
+
+func (ts *TestSuite) TestNewWithTLS() {
+	dir := ts.T().TempDir()
+
+	ca := writeCertificate(ts.T(), dir, "ca", nil)
+	serverCert := writeCertificate(ts.T(), dir, "server", ca)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	config := serverpkg.TLSConfig{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInCreatingAServerWithTLS",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile:     serverCert.CertFile,
+					KeyFile:      serverCert.KeyFile,
+					MinVersion:   "1.3",
+					CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+					ClientCAFile: ca.CertFile,
+					ClientAuth:   "require_and_verify",
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheKeyFileIsNotInformed",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile: serverCert.CertFile,
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheCertificateFileDoesNotExist",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile: filepath.Join(dir, "missing.crt"),
+					KeyFile:  serverCert.KeyFile,
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheMinimumTLSVersionIsNotSupported",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile:   serverCert.CertFile,
+					KeyFile:    serverCert.KeyFile,
+					MinVersion: "1.0",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfACipherSuiteIsInsecure",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile:     serverCert.CertFile,
+					KeyFile:      serverCert.KeyFile,
+					CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheClientCertificatesMustBeVerifiedWithoutClientCAFile",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile:   serverCert.CertFile,
+					KeyFile:    serverCert.KeyFile,
+					ClientAuth: "require_and_verify",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheClientCAFileHasNoCertificate",
+			SetUp: func(t *testing.T) {
+				config = serverpkg.TLSConfig{
+					CertFile:     serverCert.CertFile,
+					KeyFile:      serverCert.KeyFile,
+					ClientCAFile: serverCert.KeyFile,
+					ClientAuth:   "verify_if_given",
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			server, err := serverpkg.NewWithTLS(":0", handler, config)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+				assert.True(t, server.IsTLS())
+				assert.NotNil(t, server.Instance.TLSConfig)
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package server_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	serverpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/server"
+	"github.com/stretchr/testify/assert"
+)
+
+// freeAddress is the function that returns a local TCP address that is not in use.
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+// dialTLS is the function that performs a TLS handshake with the server and returns the common name of its certificate.
+func dialTLS(address string, rootCAs *x509.CertPool, clientCert *certificate) (string, error) {
+	tlsConfig := &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+
+	if clientCert != nil {
+		cert, err := tls.LoadX509KeyPair(clientCert.CertFile, clientCert.KeyFile)
+		if err != nil {
+			return "", err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	var conn *tls.Conn
+	var err error
+
+	for i := 0; i < 50; i++ {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", address, tlsConfig)
+		if opErr, ok := err.(*net.OpError); !ok || opErr.Op != "dial" {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	// The client certificate is only verified by the server after the client finishes the handshake in TLS 1.3,
+	// so a read is needed to find out whether it was accepted.
+	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	if _, err = conn.Read(make([]byte, 1)); err != nil {
+		if netErr, ok := err.(net.Error); !ok || !netErr.Timeout() {
+			return "", err
+		}
+	}
+
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func (ts *TestSuite) TestReload() {
+	dir := ts.T().TempDir()
+
+	ca := writeCertificate(ts.T(), dir, "ca", nil)
+	clientCert := writeCertificate(ts.T(), dir, "client", ca)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.Cert)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var server *serverpkg.Server
+
+	address := ""
+
+	commonName := ""
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInServingTheNewCertificateAfterReloading",
+			SetUp: func(t *testing.T) {
+				newCert := writeCertificate(t, dir, "server-2", ca)
+				os.Rename(newCert.CertFile, filepath.Join(dir, "server.crt"))
+				os.Rename(newCert.KeyFile, filepath.Join(dir, "server.key"))
+				commonName = "server-2"
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheNewKeyDoesNotMatchTheCertificateAndKeepServingTheCurrentOne",
+			SetUp: func(t *testing.T) {
+				newCert := writeCertificate(t, dir, "server-3", ca)
+				os.Rename(newCert.CertFile, filepath.Join(dir, "server.crt"))
+				commonName = "server-1"
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			serverCert := writeCertificate(t, dir, "server-1", ca)
+			os.Rename(serverCert.CertFile, filepath.Join(dir, "server.crt"))
+			os.Rename(serverCert.KeyFile, filepath.Join(dir, "server.key"))
+
+			address = freeAddress(t)
+
+			var err error
+			server, err = serverpkg.NewWithTLS(address, handler, serverpkg.TLSConfig{
+				CertFile:     filepath.Join(dir, "server.crt"),
+				KeyFile:      filepath.Join(dir, "server.key"),
+				ClientCAFile: ca.CertFile,
+				ClientAuth:   "require_and_verify",
+			})
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			serverDone := make(chan struct{})
+
+			go func() {
+				defer close(serverDone)
+				server.Start()
+			}()
+
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+				defer cancel()
+				server.Stop(ctx)
+				<-serverDone
+			}()
+
+			returnedCommonName, err := dialTLS(address, rootCAs, clientCert)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, "server-1", returnedCommonName)
+
+			_, err = dialTLS(address, rootCAs, nil)
+			assert.NotNil(t, err, "The client certificate should have been required.")
+
+			tc.SetUp(t)
+
+			err = server.Reload()
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+			}
+
+			returnedCommonName, err = dialTLS(address, rootCAs, clientCert)
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			assert.Equal(t, commonName, returnedCommonName)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// TLSConfig is the set of settings used to serve HTTPS.
+// The certificate, the key and the client CA files are reloaded when they change or when the process receives SIGHUP.
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// MinVersion is the minimum TLS version accepted, either 1.2 or 1.3.
+	MinVersion string
+	// CipherSuites are the names of the cipher suites enabled for TLS 1.2, such as TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// The Go defaults are used when it is empty. The TLS 1.3 cipher suites are not configurable.
+	CipherSuites []string
+	// ClientCAFile is the file of the certificate authorities used to verify the client certificates.
+	ClientCAFile string
+	// ClientAuth is the policy for client certificates: none, request, verify_if_given or require_and_verify.
+	ClientAuth     string
+	ReloadInterval time.Duration
+}
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"none":               tls.NoClientCert,
+	"request":            tls.RequestClientCert,
+	"verify_if_given":    tls.VerifyClientCertIfGiven,
+	"require_and_verify": tls.RequireAndVerifyClientCert,
+}
+
+// parseCipherSuites is the function that converts the names of the cipher suites into their IDs.
+// The insecure cipher suites are not accepted.
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ids := make(map[string]uint16)
+	for _, cipherSuite := range tls.CipherSuites() {
+		ids[cipherSuite.Name] = cipherSuite.ID
+	}
+
+	cipherSuites := make([]uint16, 0, len(names))
+
+	for _, name := range names {
+		id, ok := ids[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("the cipher suite %s is not supported", name)
+		}
+
+		cipherSuites = append(cipherSuites, id)
+	}
+
+	return cipherSuites, nil
+}
+
+// certReloader is the component that keeps the TLS settings built from the files up to date.
+type certReloader struct {
+	config       TLSConfig
+	minVersion   uint16
+	cipherSuites []uint16
+	clientAuth   tls.ClientAuthType
+
+	mu        sync.RWMutex
+	tlsConfig *tls.Config
+	modTimes  map[string]time.Time
+}
+
+func newCertReloader(config TLSConfig) (*certReloader, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, fmt.Errorf("both the certificate and the key files are required to serve HTTPS")
+	}
+
+	if config.MinVersion == "" {
+		config.MinVersion = "1.2"
+	}
+
+	minVersion, ok := tlsVersions[config.MinVersion]
+	if !ok {
+		return nil, fmt.Errorf("the TLS version %s is not supported", config.MinVersion)
+	}
+
+	cipherSuites, err := parseCipherSuites(config.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.ClientAuth == "" {
+		config.ClientAuth = "none"
+	}
+
+	clientAuth, ok := clientAuthTypes[config.ClientAuth]
+	if !ok {
+		return nil, fmt.Errorf("the client auth %s is not supported", config.ClientAuth)
+	}
+
+	if (clientAuth == tls.VerifyClientCertIfGiven || clientAuth == tls.RequireAndVerifyClientCert) && config.ClientCAFile == "" {
+		return nil, fmt.Errorf("the client CA file is required to verify the client certificates")
+	}
+
+	r := &certReloader{
+		config:       config,
+		minVersion:   minVersion,
+		cipherSuites: cipherSuites,
+		clientAuth:   clientAuth,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// files is the function that returns the files the TLS settings are built from.
+func (r *certReloader) files() []string {
+	files := []string{r.config.CertFile, r.config.KeyFile}
+
+	if r.config.ClientCAFile != "" {
+		files = append(files, r.config.ClientCAFile)
+	}
+
+	return files
+}
+
+// reload is the function that builds the TLS settings from the files.
+// The current settings are kept when the files cannot be loaded.
+func (r *certReloader) reload() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the TLS certificate: %s", err.Error())
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   r.minVersion,
+		CipherSuites: r.cipherSuites,
+		ClientAuth:   r.clientAuth,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if r.config.ClientCAFile != "" {
+		clientCAs, err := os.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the client CA file: %s", err.Error())
+		}
+
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(clientCAs) {
+			return fmt.Errorf("failed to parse the client CA file: no certificate was found")
+		}
+	}
+
+	r.mu.Lock()
+	r.tlsConfig = tlsConfig
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	return nil
+}
+
+// changed is the function that checks if any of the files was modified since the last reload.
+func (r *certReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getConfigForClient is the function that returns the current TLS settings for a new connection.
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.tlsConfig, nil
+}
+
+// getCertificate is the function that returns the current certificate for a new connection.
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return &r.tlsConfig.Certificates[0], nil
+}
+
+// watch is the function that reloads the TLS settings when the files change or when the process receives SIGHUP,
+// until the done channel is closed.
+func (r *certReloader) watch(done <-chan struct{}) {
+	interval := r.config.ReloadInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	hangupChan := make(chan os.Signal, 1)
+	signal.Notify(hangupChan, syscall.SIGHUP)
+	defer signal.Stop(hangupChan)
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-hangupChan:
+			r.reloadAndLog("SIGHUP")
+		case <-ticker.C:
+			if r.changed() {
+				r.reloadAndLog("file change")
+			}
+		}
+	}
+}
+
+func (r *certReloader) reloadAndLog(reason string) {
+	if err := r.reload(); err != nil {
+		slog.Error("failed to reload the TLS certificate", slog.String("reason", reason), slog.Any("error", err))
+		return
+	}
+
+	slog.Info("TLS certificate reloaded", slog.String("reason", reason))
+}
@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+// GormPlugin is the GORM plugin that wraps every database operation in a span carrying its SQL statement.
+type GormPlugin struct{}
+
+// NewGormPlugin is the factory function that encapsulates the implementation related to the GORM tracing plugin.
+func NewGormPlugin() gorm.Plugin {
+	return &GormPlugin{}
+}
+
+// Name is the function that returns the name of the plugin.
+func (p *GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize is the function that registers the callbacks that start and end the spans of the database operations.
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+
+	if err := callback.Create().Before("gorm:create").Register("tracing:before_create", startSpan("create")); err != nil {
+		return err
+	}
+
+	if err := callback.Create().After("gorm:create").Register("tracing:after_create", endSpan); err != nil {
+		return err
+	}
+
+	if err := callback.Query().Before("gorm:query").Register("tracing:before_query", startSpan("query")); err != nil {
+		return err
+	}
+
+	if err := callback.Query().After("gorm:query").Register("tracing:after_query", endSpan); err != nil {
+		return err
+	}
+
+	if err := callback.Update().Before("gorm:update").Register("tracing:before_update", startSpan("update")); err != nil {
+		return err
+	}
+
+	if err := callback.Update().After("gorm:update").Register("tracing:after_update", endSpan); err != nil {
+		return err
+	}
+
+	if err := callback.Delete().Before("gorm:delete").Register("tracing:before_delete", startSpan("delete")); err != nil {
+		return err
+	}
+
+	if err := callback.Delete().After("gorm:delete").Register("tracing:after_delete", endSpan); err != nil {
+		return err
+	}
+
+	if err := callback.Row().Before("gorm:row").Register("tracing:before_row", startSpan("row")); err != nil {
+		return err
+	}
+
+	if err := callback.Row().After("gorm:row").Register("tracing:after_row", endSpan); err != nil {
+		return err
+	}
+
+	if err := callback.Raw().Before("gorm:raw").Register("tracing:before_raw", startSpan("raw")); err != nil {
+		return err
+	}
+
+	if err := callback.Raw().After("gorm:raw").Register("tracing:after_raw", endSpan); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func startSpan(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+
+		_, span := Start(db.Statement.Context, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+		span.SetAttributes(attribute.String("db.operation", operation))
+
+		if db.Statement.Table != "" {
+			span.SetAttributes(attribute.String("db.sql.table", db.Statement.Table))
+		}
+
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.statement", db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		RecordError(span, db.Error)
+	}
+}
@@ -0,0 +1,82 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func (ts *TestSuite) TestGormPlugin() {
+	sqlQuery := `SELECT * FROM "users"`
+
+	var mock sqlmock.Sqlmock
+
+	statusCode := codes.Unset
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInRecordingASpanForADatabaseOperation",
+			SetUp: func(t *testing.T) {
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				statusCode = codes.Unset
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInRecordingASpanForAFailedDatabaseOperation",
+			SetUp: func(t *testing.T) {
+				mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+					WillReturnError(fmt.Errorf("failed"))
+
+				statusCode = codes.Error
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			spanRecorder := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+
+			sqlDB, sqlMock, err := sqlmock.New()
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+			mock = sqlMock
+
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			err = db.Use(tracingpkg.NewGormPlugin())
+			assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+			tc.SetUp(t)
+
+			rows := make([]map[string]interface{}, 0)
+			result := db.WithContext(context.Background()).Table("users").Find(&rows)
+
+			if !tc.WantError {
+				assert.Nil(t, result.Error, fmt.Sprintf("Unexpected error: %v", result.Error))
+			} else {
+				assert.NotNil(t, result.Error, "Predicted error lost.")
+			}
+
+			spans := spanRecorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, "gorm.query", spans[0].Name())
+			assert.Equal(t, statusCode, spans[0].Status().Code)
+		})
+	}
+}
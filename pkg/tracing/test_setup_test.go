@@ -0,0 +1,90 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tracingpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+)
+
+func (ts *TestSuite) TestSetup() {
+	config := tracingpkg.Config{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSettingUpTheTracingWithoutExporter",
+			SetUp: func(t *testing.T) {
+				config = tracingpkg.Config{
+					ServiceName: "testing",
+					Exporter:    "none",
+					SampleRatio: 1,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldSucceedInSettingUpTheTracingWithTheFileExporter",
+			SetUp: func(t *testing.T) {
+				config = tracingpkg.Config{
+					ServiceName: "testing",
+					Exporter:    "file",
+					FilePath:    filepath.Join(t.TempDir(), "traces.json"),
+					SampleRatio: 1,
+				}
+			},
+			WantError: false,
+		},
+		{
+			Context: "ItShouldFailIfTheExporterIsNotRecognized",
+			SetUp: func(t *testing.T) {
+				config = tracingpkg.Config{
+					ServiceName: "testing",
+					Exporter:    "testing",
+				}
+			},
+			WantError: true,
+		},
+		{
+			Context: "ItShouldFailIfTheTracesFileCannotBeOpened",
+			SetUp: func(t *testing.T) {
+				config = tracingpkg.Config{
+					ServiceName: "testing",
+					Exporter:    "file",
+					FilePath:    filepath.Join(t.TempDir(), "missing", "traces.json"),
+				}
+			},
+			WantError: true,
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			shutdown, err := tracingpkg.Setup(context.Background(), config)
+
+			if !tc.WantError {
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				_, span := tracingpkg.Start(context.Background(), "testing")
+				span.End()
+
+				err = shutdown(context.Background())
+				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+
+				if config.Exporter == "file" {
+					content, err := os.ReadFile(config.FilePath)
+					assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
+					assert.Contains(t, string(content), `"Name":"testing"`)
+				}
+			} else {
+				assert.NotNil(t, err, "Predicted error lost.")
+				assert.Nil(t, shutdown)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/icaroribeiro/go-code-challenge-template"
+
+// Config is the model of the settings used to set up the tracing.
+// The exporter is one of none, otlp, stdout or file. The OTLP exporter is configured
+// through the standard OTEL_EXPORTER_OTLP_* environment variables.
+type Config struct {
+	ServiceName string
+	Exporter    string
+	FilePath    string
+	SampleRatio float64
+}
+
+// ShutdownFunc is the function that flushes the pending spans and releases the resources of the tracing.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup is the function that configures the global tracer provider and the W3C trace context propagator.
+// When no exporter is configured, the spans are not recorded although the incoming trace context is still propagated.
+func Setup(ctx context.Context, config Config) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var closer io.Closer
+	var err error
+
+	switch strings.ToLower(config.Exporter) {
+	case "", "none":
+		return func(ctx context.Context) error { return nil }, nil
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "file":
+		file, fileErr := os.OpenFile(config.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if fileErr != nil {
+			return nil, customerror.Newf("failed to open the traces file %s: %s", config.FilePath, fileErr.Error())
+		}
+
+		closer = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, customerror.Newf("the tracing exporter %s is not recognized", config.Exporter)
+	}
+
+	if err != nil {
+		return nil, customerror.Newf("failed to create the tracing exporter: %s", err.Error())
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", config.ServiceName))),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+
+	return func(ctx context.Context) error {
+		err := tracerProvider.Shutdown(ctx)
+
+		if closer != nil {
+			if closeErr := closer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+
+		return err
+	}, nil
+}
+
+// Start is the function that starts a span as a child of the one stored in context, if any.
+func Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, opts...)
+}
+
+// RecordError is the function that records an error in a span and marks it as failed.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -12,6 +12,8 @@ func (ts *TestSuite) TestValidate() {
 	validator, err := validatorpkg.New(nil)
 	assert.Nil(ts.T(), err, fmt.Sprintf("Unexpected error: %v", err))
 
+	violations := validatorpkg.Violations{}
+
 	ts.Cases = Cases{
 		{
 			Context: "ItShouldSucceedWithTheValidateTag",
@@ -24,17 +26,40 @@ func (ts *TestSuite) TestValidate() {
 			Context:   "ItShouldFailWithTheValidateTagBecuaseField1IsEmpty",
 			Inf:       Foo{},
 			WantError: true,
+			SetUp: func(t *testing.T) {
+				violations = validatorpkg.Violations{
+					{Field: "Field1", Rule: "nonzero", Message: "must be informed"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldFailWithTheViolationsOfTheRulesIdentifiedByTheJSONPathsOfTheFields",
+			Inf: Bar{
+				Name: "ab",
+			},
+			WantError: true,
+			SetUp: func(t *testing.T) {
+				violations = validatorpkg.Violations{
+					{Field: "inner.Field1", Rule: "nonzero", Message: "must be informed"},
+					{Field: "name", Rule: "min", Params: map[string]string{"min": "3"}, Message: "must be at least 3"},
+				}
+			},
 		},
 	}
 
 	for _, tc := range ts.Cases {
 		ts.T().Run(tc.Context, func(t *testing.T) {
+			if tc.SetUp != nil {
+				tc.SetUp(t)
+			}
+
 			err := validator.Validate(tc.Inf)
 
 			if !tc.WantError {
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v", err))
 			} else {
 				assert.NotNil(t, err, "Predicted error lost")
+				assert.Equal(t, violations, err)
 			}
 		})
 	}
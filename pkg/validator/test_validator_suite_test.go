@@ -21,6 +21,11 @@ type Foo struct {
 	Field1 string `validate:"nonzero"`
 }
 
+type Bar struct {
+	Name  string `validate:"nonzero, min=3" json:"name"`
+	Inner Foo    `json:"inner"`
+}
+
 type TestSuite struct {
 	suite.Suite
 	Cases Cases
@@ -0,0 +1,60 @@
+package validator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+	validatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator"
+	uuidvalidatorpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/validator/uuid"
+	"github.com/stretchr/testify/assert"
+	validatorv2 "gopkg.in/validator.v2"
+)
+
+func (ts *TestSuite) TestWithField() {
+	validationFuncs := map[string]validatorv2.ValidationFunc{
+		"uuid": uuidvalidatorpkg.Validate,
+	}
+
+	validator, err := validatorpkg.New(validationFuncs)
+	assert.Nil(ts.T(), err)
+
+	var validationErr error
+	fieldErrors := []customerror.FieldError{}
+
+	ts.Cases = Cases{
+		{
+			Context: "ItShouldSucceedInSettingTheFieldOfTheViolationsOfAValue",
+			SetUp: func(t *testing.T) {
+				validationErr = validator.ValidateWithTags("foo", "nonzero, uuid")
+				fieldErrors = []customerror.FieldError{
+					{Field: "user_id", Rule: "uuid", Message: "must contain an identifier following the UUID standard"},
+				}
+			},
+		},
+		{
+			Context: "ItShouldSucceedInKeepingAnErrorThatIsNotAViolation",
+			SetUp: func(t *testing.T) {
+				validationErr = errors.New("failed")
+				fieldErrors = nil
+			},
+		},
+	}
+
+	for _, tc := range ts.Cases {
+		ts.T().Run(tc.Context, func(t *testing.T) {
+			tc.SetUp(t)
+
+			err := validatorpkg.WithField(validationErr, "user_id")
+
+			assert.Equal(t, fieldErrors, customerror.GetFieldErrors(customerror.BadRequest.Newf("%w", err)))
+
+			if fieldErrors != nil {
+				assert.Equal(t, customerror.BadRequest, customerror.GetType(err))
+				assert.Equal(t, "user_id: must contain an identifier following the UUID standard", err.Error())
+			} else {
+				assert.Equal(t, validationErr, err)
+			}
+		})
+	}
+}
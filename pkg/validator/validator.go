@@ -1,21 +1,60 @@
 package validator
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	validatorv2 "gopkg.in/validator.v2"
 )
 
+// builtinRules are the names of the validation rules provided by validatorv2.
+var builtinRules = []string{"nonzero", "nonnil", "min", "max", "len", "regexp"}
+
 type Validator struct {
 	ValidatorV2 *validatorv2.Validator
+	Templates   MessageTemplates
+}
+
+// ruleError is the error of a validation rule, which keeps the rule and its parameter to build the violation.
+type ruleError struct {
+	rule  string
+	param string
+	err   error
+}
+
+func (e ruleError) Error() string {
+	return e.err.Error()
 }
 
 // New is the factory function that encapsulates the implementation related to validator.
 func New(validationFuncs map[string]validatorv2.ValidationFunc) (IValidator, error) {
 	vv2 := validatorv2.NewValidator()
+	vv2.SetPrintJSON(true)
+
+	// The builtin rules are run by a separate validator so that their errors can be associated with their names.
+	builtins := validatorv2.NewValidator()
+
+	for _, name := range builtinRules {
+		name := name
+
+		builtin := func(v interface{}, param string) error {
+			tag := name
+			if param != "" {
+				tag += "=" + strings.ReplaceAll(param, ",", `\,`)
+			}
+
+			return builtins.Valid(v, tag)
+		}
+
+		if err := vv2.SetValidationFunc(name, withRule(name, builtin)); err != nil {
+			return &Validator{}, fmt.Errorf("failed to set the validation function for the %s field: %s", name, err.Error())
+		}
+	}
 
 	for name, validationFunc := range validationFuncs {
-		err := vv2.SetValidationFunc(name, validationFunc)
+		err := vv2.SetValidationFunc(name, withRule(name, validationFunc))
 		if err != nil {
 			msg := "failed to set the validation function for the %s field: %s"
 			return &Validator{}, fmt.Errorf(msg, name, err.Error())
@@ -24,15 +63,95 @@ func New(validationFuncs map[string]validatorv2.ValidationFunc) (IValidator, err
 
 	return &Validator{
 		ValidatorV2: vv2,
+		Templates:   DefaultMessageTemplates,
 	}, nil
 }
 
-// Validateis the function that validates the fields of structs based on 'validator' tags.
+// withRule is the function that wraps a validation function so that its error carries the rule and its parameter.
+func withRule(rule string, validationFunc validatorv2.ValidationFunc) validatorv2.ValidationFunc {
+	return func(v interface{}, param string) error {
+		err := validationFunc(v, param)
+		if err == nil {
+			return nil
+		}
+
+		// The builtin rules run by a separate validator report their errors in an array.
+		var errs validatorv2.ErrorArray
+		if errors.As(err, &errs) && len(errs) == 1 {
+			err = errs[0]
+		}
+
+		return ruleError{rule: rule, param: param, err: err}
+	}
+}
+
+// Validate is the function that validates the fields of structs based on 'validator' tags.
+// The error is a list of Violations, whose fields are the JSON paths of the failed fields.
 func (v *Validator) Validate(i interface{}) error {
-	return v.ValidatorV2.Validate(i)
+	err := v.ValidatorV2.Validate(i)
+	if err == nil {
+		return nil
+	}
+
+	errorMap, ok := err.(validatorv2.ErrorMap)
+	if !ok {
+		return v.violations("", err)
+	}
+
+	fields := make([]string, 0, len(errorMap))
+	for field := range errorMap {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	violations := make(Violations, 0, len(errorMap))
+
+	for _, field := range fields {
+		violations = append(violations, v.violations(field, errorMap[field])...)
+	}
+
+	return violations
 }
 
 // ValidateWithTags is the function that validates a value based on the provided tags.
+// The error is a list of Violations without field, which can be set with WithField.
 func (v *Validator) ValidateWithTags(i interface{}, tags string) error {
-	return v.ValidatorV2.Valid(i, tags)
+	err := v.ValidatorV2.Valid(i, tags)
+	if err == nil {
+		return nil
+	}
+
+	return v.violations("", err)
+}
+
+// violations is the function that builds the violations of a field from the errors of its rules.
+func (v *Validator) violations(field string, err error) Violations {
+	errs, ok := err.(validatorv2.ErrorArray)
+	if !ok {
+		errs = validatorv2.ErrorArray{err}
+	}
+
+	violations := make(Violations, 0, len(errs))
+
+	for _, err := range errs {
+		violation := Violation{Field: field, Message: err.Error()}
+
+		if ruleErr, ok := err.(ruleError); ok {
+			violation.Rule = ruleErr.rule
+			violation.Message = ruleErr.err.Error()
+
+			if ruleErr.param != "" {
+				violation.Params = map[string]string{ruleErr.rule: ruleErr.param}
+			}
+
+			if template, ok := v.Templates[ruleErr.rule]; ok {
+				violation.Message = violation.Render(template)
+			}
+		}
+
+		violations = append(violations, violation)
+	}
+
+	return violations
 }
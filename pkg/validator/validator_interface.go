@@ -1,6 +1,7 @@
 package validator
 
 // IValidator transport is the validator's contract.
+// The errors of the validations are Violations, which list the failed rules by field.
 type IValidator interface {
 	Validate(i interface{}) error
 	ValidateWithTags(i interface{}, tags string) error
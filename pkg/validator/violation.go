@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/icaroribeiro/go-code-challenge-template/pkg/customerror"
+)
+
+// MessageTemplates are the templates of the messages of the violations by rule, such as "must be at least {min}".
+// The parameter of a rule is referenced by the name of the rule between braces.
+type MessageTemplates map[string]string
+
+// DefaultMessageTemplates are the templates used when the validator is created without its own.
+var DefaultMessageTemplates = MessageTemplates{
+	"nonzero":  "must be informed",
+	"nonnil":   "must be informed",
+	"min":      "must be at least {min}",
+	"max":      "must be at most {max}",
+	"len":      "must have a length of {len}",
+	"regexp":   "must match the pattern {regexp}",
+	"uuid":     "must contain an identifier following the UUID standard",
+	"username": "must contain only letters and digits with at least 5 characters",
+	"password": "must contain only letters and digits with at least 8 characters",
+}
+
+// Violation is the failure of a validation rule by a field.
+type Violation struct {
+	// Field is the JSON path of the field, such as credentials.username or items[0].name.
+	// It is empty when a single value is validated.
+	Field  string
+	Rule   string
+	Params map[string]string
+	// Message is the message rendered from the template of the rule, or the one of the error of the rule if there is none.
+	Message string
+}
+
+// Render is the function that renders the message of the violation from a template, so that it can be localized.
+func (v Violation) Render(template string) string {
+	message := template
+
+	for name, value := range v.Params {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+
+	return message
+}
+
+// Violations is the error that lists the failed validation rules.
+type Violations []Violation
+
+// Error is the function that returns the messages of the violations preceded by their fields.
+func (v Violations) Error() string {
+	messages := make([]string, 0, len(v))
+
+	for _, violation := range v {
+		if violation.Field == "" {
+			messages = append(messages, violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Field+": "+violation.Message)
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+// ErrorType is the function that gets the type of the violations, so that they are responded with 400 Bad Request.
+func (v Violations) ErrorType() customerror.ErrorType {
+	return customerror.BadRequest
+}
+
+// FieldErrors is the function that converts the violations into the errors of the fields of the request.
+func (v Violations) FieldErrors() []customerror.FieldError {
+	fieldErrors := make([]customerror.FieldError, 0, len(v))
+
+	for _, violation := range v {
+		fieldErrors = append(fieldErrors, customerror.FieldError{
+			Field:   violation.Field,
+			Rule:    violation.Rule,
+			Params:  violation.Params,
+			Message: violation.Message,
+		})
+	}
+
+	return fieldErrors
+}
+
+// WithField is the function that sets the field of the violations of a single value validated with ValidateWithTags.
+// The errors that are not violations are returned unchanged.
+func WithField(err error, field string) error {
+	var violations Violations
+	if !errors.As(err, &violations) {
+		return err
+	}
+
+	withField := make(Violations, 0, len(violations))
+
+	for _, violation := range violations {
+		if violation.Field == "" {
+			violation.Field = field
+		}
+
+		withField = append(withField, violation)
+	}
+
+	return withField
+}
@@ -4,6 +4,107 @@
 # HTTP server settings
 #
 export HTTP_PORT="8081"
+export HTTP_READ_TIMEOUT_IN_SEC="15"
+export HTTP_READ_HEADER_TIMEOUT_IN_SEC="5"
+export HTTP_WRITE_TIMEOUT_IN_SEC="30"
+export HTTP_IDLE_TIMEOUT_IN_SEC="120"
+export HTTP_MAX_HEADER_BYTES="1048576"
+export HTTP_H2C="false"
+export HTTP_UNIX_SOCKET=""
+export HTTP_SYSTEMD_SOCKET="false"
+
+export SHUTDOWN_DRAIN_PERIOD_IN_SEC="5"
+export SHUTDOWN_TIMEOUT_IN_SEC="10"
+
+export HEALTH_CHECK_TIMEOUT_IN_SEC="2"
+
+#
+# TLS settings
+#
+export TLS_CERT_FILE=""
+export TLS_KEY_FILE=""
+export TLS_MIN_VERSION="1.2"
+export TLS_CIPHER_SUITES=""
+export TLS_CLIENT_CA_FILE=""
+export TLS_CLIENT_AUTH="none"
+export TLS_RELOAD_INTERVAL_IN_SEC="10"
+
+#
+# Metrics settings
+#
+export METRICS_PORT=""
+
+#
+# gRPC settings
+#
+export GRPC_PORT="9090"
+export GRPC_HEALTH_WATCH_INTERVAL_IN_SEC="5"
+
+#
+# GraphQL settings
+#
+export GRAPHQL_MAX_DEPTH="10"
+export GRAPHQL_MAX_COMPLEXITY="1000"
+export GRAPHQL_DEFAULT_PAGE_SIZE="20"
+export GRAPHQL_MAX_PAGE_SIZE="100"
+
+#
+# Tracing settings
+#
+export TRACING_EXPORTER="none"
+export TRACING_FILE_PATH="./traces.json"
+export TRACING_SAMPLE_RATIO="1"
+export TRACING_SERVICE_NAME="go-code-challenge-template"
+
+#
+# CORS settings
+#
+export CORS_ALLOWED_ORIGINS=""
+export CORS_ALLOWED_METHODS="GET,POST,PUT,PATCH,DELETE"
+export CORS_ALLOWED_HEADERS="Authorization,Content-Type,If-Match,X-Request-ID"
+export CORS_EXPOSED_HEADERS="ETag,X-Request-ID,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,RateLimit-Policy,Retry-After"
+export CORS_ALLOW_CREDENTIALS="false"
+export CORS_MAX_AGE_IN_SEC="600"
+
+#
+# Security settings
+#
+export HSTS_MAX_AGE_IN_SEC="31536000"
+export FRAME_OPTIONS="DENY"
+export MAX_REQUEST_BODY_SIZE_IN_BYTES="1048576"
+
+#
+# Error settings
+#
+export PROBLEM_TYPE_BASE_URI=""
+
+#
+# Versioning settings
+#
+export UNVERSIONED_ROUTES="true"
+export UNVERSIONED_ROUTES_SUNSET=""
+
+#
+# OpenAPI settings
+#
+export OPENAPI_VALIDATE_REQUESTS="false"
+export OPENAPI_VALIDATE_RESPONSES="true"
+
+#
+# Rate limit settings
+#
+export RATE_LIMIT_STORE="memory"
+export RATE_LIMIT_REDIS_URL="redis://localhost:6379/0"
+export RATE_LIMIT_TRUSTED_PROXIES=""
+export RATE_LIMIT_SIGN_UP="5/1m"
+export RATE_LIMIT_SIGN_IN="10/1m"
+export RATE_LIMIT_CHANGE_PASSWORD="5/1m"
+
+#
+# Logging settings
+#
+export LOG_FORMAT="text"
+export LOG_LEVEL="warn"
 
 #
 # RSA Keys
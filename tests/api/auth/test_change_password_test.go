@@ -17,6 +17,7 @@ import (
 	userdatastorerepository "github.com/icaroribeiro/go-code-challenge-template/internal/infrastructure/datastore/repository/user"
 	authhandler "github.com/icaroribeiro/go-code-challenge-template/internal/presentation/api/handler/auth"
 	authpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/auth"
+	etaghttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/etag"
 	requesthttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/request"
 	responsehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/response"
 	routehttputilpkg "github.com/icaroribeiro/go-code-challenge-template/pkg/httputil/route"
@@ -41,6 +42,8 @@ func (ts *TestSuite) TestChangePassword() {
 
 	body := ""
 
+	headers := map[string][]string{}
+
 	authDetailsCtxValue := domainentity.Auth{}
 
 	ts.Cases = Cases{
@@ -208,6 +211,67 @@ func (ts *TestSuite) TestChangePassword() {
 			WantError:  true,
 			TearDown:   func(t *testing.T) {},
 		},
+		{
+			Context: "ItShouldFailIfTheLoginWasModifiedSinceTheVersionInformedInTheIfMatchHeader",
+			SetUp: func(t *testing.T) {
+				dbTrx = ts.DB.Begin()
+				assert.Nil(t, dbTrx.Error, fmt.Sprintf("Unexpected error: %v.", dbTrx.Error))
+
+				authN = authpkg.New(ts.RSAKeys)
+
+				username := fake.Username()
+				password := fake.Password(true, true, true, false, false, 8)
+
+				persistentUser = persistententity.User{
+					Username: username,
+				}
+
+				result := dbTrx.Create(&persistentUser)
+				assert.Nil(t, result.Error, fmt.Sprintf("Unexpected error: %v.", result.Error))
+
+				persistentLogin = persistententity.Login{
+					UserID:   persistentUser.ID,
+					Username: username,
+					Password: password,
+				}
+
+				result = dbTrx.Create(&persistentLogin)
+				assert.Nil(t, result.Error, fmt.Sprintf("Unexpected error: %v.", result.Error))
+
+				persistentAuth = persistententity.Auth{
+					UserID: persistentUser.ID,
+				}
+
+				result = dbTrx.Create(&persistentAuth)
+				assert.Nil(t, result.Error, fmt.Sprintf("Unexpected error: %v.", result.Error))
+
+				auth = persistentAuth.ToDomain()
+
+				passwords = securitypkg.Passwords{
+					CurrentPassword: password,
+					NewPassword:     fake.Password(true, true, true, false, false, 8),
+				}
+
+				body = fmt.Sprintf(`
+				{
+					"current_password":"%s",
+					"new_password":"%s"
+				}`,
+					passwords.CurrentPassword, passwords.NewPassword)
+
+				headers = map[string][]string{
+					"If-Match": {etaghttputilpkg.Format(persistentLogin.Version + 1)},
+				}
+
+				authDetailsCtxValue = auth
+			},
+			StatusCode: http.StatusPreconditionFailed,
+			WantError:  true,
+			TearDown: func(t *testing.T) {
+				result := dbTrx.Rollback()
+				assert.Nil(t, result.Error, fmt.Sprintf("Unexpected error: %v.", result.Error))
+			},
+		},
 	}
 
 	for _, tc := range ts.Cases {
@@ -230,15 +294,18 @@ func (ts *TestSuite) TestChangePassword() {
 			}
 
 			requestData := requesthttputilpkg.RequestData{
-				Method: route.Method,
-				Target: route.Path,
-				Body:   body,
+				Method:  route.Method,
+				Target:  route.Path,
+				Body:    body,
+				Headers: headers,
 			}
 
 			reqBody := requesthttputilpkg.PrepareRequestBody(requestData.Body)
 
 			req := httptest.NewRequest(requestData.Method, requestData.Target, reqBody)
 
+			requesthttputilpkg.SetRequestHeaders(req, requestData.Headers)
+
 			ctx := req.Context()
 			ctx = authmiddlewarepkg.NewContext(ctx, authDetailsCtxValue)
 			req = req.WithContext(ctx)
@@ -260,6 +327,7 @@ func (ts *TestSuite) TestChangePassword() {
 				err := json.NewDecoder(resprec.Body).Decode(&returnedMessage)
 				assert.Nil(t, err, fmt.Sprintf("Unexpected error: %v.", err))
 				assert.NotEmpty(t, returnedMessage.Text)
+				assert.Equal(t, etaghttputilpkg.Format(persistentLogin.Version+1), resprec.Header().Get("ETag"))
 			} else {
 				assert.Equal(t, resprec.Code, tc.StatusCode)
 			}
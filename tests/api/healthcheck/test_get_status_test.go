@@ -55,7 +55,7 @@ func (ts *TestSuite) TestGetStatus() {
 		ts.T().Run(tc.Context, func(t *testing.T) {
 			tc.SetUp(t)
 
-			healthCheckService := healthcheckservice.New(db)
+			healthCheckService := healthcheckservice.New(db, nil, nil)
 			healthCheckHandler := healthcheckhandler.New(healthCheckService)
 
 			route := routehttputilpkg.Route{